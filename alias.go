@@ -0,0 +1,226 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+)
+
+// AliasActions defines a method which knows how to make an OpenSearch [Alias Actions] request.
+// It should be implemented by a version-specific executor.
+//
+// [Alias Actions]: https://opensearch.org/docs/latest/api-reference/index-apis/alias/
+type AliasActions interface {
+	AliasActions(ctx context.Context, req *AliasActionsRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// GetAliases defines a method which knows how to make an OpenSearch [Get Aliases] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get Aliases]: https://opensearch.org/docs/latest/im-plugin/index-alias/#manage-aliases
+type GetAliases interface {
+	GetAliases(ctx context.Context, req *GetAliasesRequest) (OpenSearchResponse[GetAliasesResponse], error)
+}
+
+// Alias is a domain model union type for an index alias for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-alias/
+type Alias struct {
+	// Name of the alias
+	Name string
+
+	// Filter limits the documents visible through the alias
+	Filter Query
+
+	// Routing value used for both indexing and search operations through the alias
+	Routing string
+
+	// IndexRouting value used for indexing operations through the alias, overrides Routing
+	IndexRouting string
+
+	// SearchRouting value used for search operations through the alias, overrides Routing
+	SearchRouting string
+
+	// IsWriteIndex marks the index as the write index of the alias. Omitted if nil.
+	IsWriteIndex *bool
+}
+
+// NewAlias instantiates an Alias with the given name.
+func NewAlias(name string) *Alias {
+	return &Alias{
+		Name: name,
+	}
+}
+
+// WithFilter sets the query filtering the documents visible through the alias
+func (a *Alias) WithFilter(filter Query) *Alias {
+	a.Filter = filter
+	return a
+}
+
+// WithRouting sets the routing value of both indexing and search operations
+func (a *Alias) WithRouting(routing string) *Alias {
+	a.Routing = routing
+	return a
+}
+
+// WithIndexRouting sets the routing value of indexing operations
+func (a *Alias) WithIndexRouting(routing string) *Alias {
+	a.IndexRouting = routing
+	return a
+}
+
+// WithSearchRouting sets the routing value of search operations
+func (a *Alias) WithSearchRouting(routing string) *Alias {
+	a.SearchRouting = routing
+	return a
+}
+
+// WithIsWriteIndex sets whether the index is the write index of the alias
+func (a *Alias) WithIsWriteIndex(isWriteIndex bool) *Alias {
+	a.IsWriteIndex = &isWriteIndex
+	return a
+}
+
+// AliasActionType is the type of an [AliasAction].
+type AliasActionType string
+
+const (
+	// AliasAdd adds the alias to the indices
+	AliasAdd AliasActionType = "add"
+	// AliasRemove removes the alias from the indices
+	AliasRemove AliasActionType = "remove"
+	// AliasRemoveIndex deletes the indices, typically replaced by an index the alias is added to in the same request
+	AliasRemoveIndex AliasActionType = "remove_index"
+)
+
+// AliasAction is a single action of an [AliasActionsRequest].
+type AliasAction struct {
+	// Type of the action
+	Type AliasActionType
+
+	// Indices the action applies to, wildcards are supported
+	Indices []string
+
+	// Alias to add, or whose name to remove. Nil for [AliasRemoveIndex] actions.
+	Alias *Alias
+}
+
+// AliasActionsRequest is a domain model union type for all the fields of an Alias Actions request for all
+// supported OpenSearch versions. All the actions are applied atomically.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This AliasActionsRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	actionsReq := NewAliasActionsRequest().
+//		RemoveAlias("example_alias", "example_index_v1").
+//		AddAlias(NewAlias("example_alias").WithIsWriteIndex(true), "example_index_v2")
+//	actionsResp, err := osv2Executor.AliasActions(ctx, actionsReq)
+type AliasActionsRequest struct {
+	// Actions to apply, in order
+	Actions []AliasAction
+}
+
+// NewAliasActionsRequest instantiates an AliasActionsRequest with no actions.
+func NewAliasActionsRequest() *AliasActionsRequest {
+	return &AliasActionsRequest{}
+}
+
+// AddAlias adds an action adding the alias to the indices
+func (r *AliasActionsRequest) AddAlias(alias *Alias, indices ...string) *AliasActionsRequest {
+	r.Actions = append(r.Actions, AliasAction{
+		Type:    AliasAdd,
+		Indices: indices,
+		Alias:   alias,
+	})
+	return r
+}
+
+// RemoveAlias adds an action removing the alias with the given name from the indices
+func (r *AliasActionsRequest) RemoveAlias(name string, indices ...string) *AliasActionsRequest {
+	r.Actions = append(r.Actions, AliasAction{
+		Type:    AliasRemove,
+		Indices: indices,
+		Alias:   NewAlias(name),
+	})
+	return r
+}
+
+// RemoveIndex adds an action deleting the indices
+func (r *AliasActionsRequest) RemoveIndex(indices ...string) *AliasActionsRequest {
+	r.Actions = append(r.Actions, AliasAction{
+		Type:    AliasRemoveIndex,
+		Indices: indices,
+	})
+	return r
+}
+
+// GetAliasesRequest is a domain model union type for all the fields of a Get Aliases request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetAliasesRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetAliasesRequest().WithAliases("example_alias")
+//	getResp, err := osv2Executor.GetAliases(ctx, getReq)
+type GetAliasesRequest struct {
+	// Indices whose aliases to get, wildcards are supported. All indices if empty.
+	Indices []string
+
+	// Aliases to get, wildcards are supported. All aliases if empty.
+	Aliases []string
+}
+
+// NewGetAliasesRequest instantiates a GetAliasesRequest for the aliases of the given indices.
+func NewGetAliasesRequest(indices ...string) *GetAliasesRequest {
+	return &GetAliasesRequest{
+		Indices: indices,
+	}
+}
+
+// WithAliases sets the names of the aliases to get
+func (r *GetAliasesRequest) WithAliases(aliases ...string) *GetAliasesRequest {
+	r.Aliases = aliases
+	return r
+}
+
+// GetAliasesResponse is a domain model union response type for GetAliasesRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetAliasesResponse struct {
+	// Aliases of the indices, by index name. The filters of the aliases are [RawQuery]s.
+	Aliases map[string][]*Alias
+
+	// Error of the request, such as when an alias doesn't exist. Aliases holds the aliases which were found.
+	Error *Error
+}
+
+// SwapAlias atomically moves the alias from the index from to the index to,
+// such as when switching from a blue index to a green one.
+// The alias is added to the index to with no filter nor routing.
+// An error is returned if the request fails or isn't acknowledged.
+func SwapAlias(ctx context.Context, client AliasActions, alias, from, to string) error {
+	req := NewAliasActionsRequest().
+		RemoveAlias(alias, from).
+		AddAlias(NewAlias(alias), to)
+
+	resp, err := client.AliasActions(ctx, req)
+	if err != nil {
+		return fmt.Errorf("swapping alias %s from %s to %s: %w", alias, from, to, err)
+	}
+
+	if resp.Response.Error != nil {
+		return fmt.Errorf("swapping alias %s from %s to %s failed: %s: %s", alias, from, to, resp.Response.Error.Type, resp.Response.Error.Reason)
+	}
+
+	if !resp.Response.Acknowledged {
+		return fmt.Errorf("swapping alias %s from %s to %s wasn't acknowledged", alias, from, to)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package opensearchtools
 
 import (
 	"context"
+	"time"
 )
 
 // Bulk defines a method which knows how to make an OpenSearch [Bulk] request.
@@ -30,6 +31,39 @@ type BulkRequest struct {
 
 	// ParseResponseItemsOnlyOnFailure determines if the bulk response is parsed all the time or only when errors present in the response
 	ParseResponseItemsOnlyOnFailure bool
+
+	// Pipeline is the ingest pipeline used to preprocess all documents in the request
+	Pipeline string
+
+	// Routing value used to route all actions in the request to a specific shard
+	Routing string
+
+	// Timeout for waiting on active shards and the request to complete. Omitted if zero.
+	Timeout time.Duration
+
+	// WaitForActiveShards is the number of active shard copies required before performing the request, such as "all" or "2"
+	WaitForActiveShards string
+
+	// RequireAlias requires the target index of all actions to be an alias
+	RequireAlias bool
+
+	// DataStream marks Index as a data stream, which only accepts BulkCreate actions
+	DataStream bool
+
+	// Source determines if the document source is returned for update actions, either "true", "false", or a list of fields
+	Source []string
+
+	// SourceIncludes lists the source fields to be returned for update actions
+	SourceIncludes []string
+
+	// SourceExcludes lists the source fields to be excluded for update actions
+	SourceExcludes []string
+
+	// Streaming encodes the request body while it is sent and decodes the response items as they are read,
+	// instead of buffering the entire request and response in memory.
+	// The request body is only streamed if the opensearch.Client is built with DisableRetry, otherwise the
+	// client buffers the entire body to be able to retry it and only the response is streamed.
+	Streaming bool
 }
 
 // NewBulkRequest instantiates an empty BulkRequest
@@ -49,6 +83,67 @@ func (r *BulkRequest) WithIndex(index string) *BulkRequest {
 	return r
 }
 
+// WithPipeline sets the ingest pipeline for the request
+func (r *BulkRequest) WithPipeline(pipeline string) *BulkRequest {
+	r.Pipeline = pipeline
+	return r
+}
+
+// WithRouting sets the routing value for the request
+func (r *BulkRequest) WithRouting(routing string) *BulkRequest {
+	r.Routing = routing
+	return r
+}
+
+// WithTimeout sets the request timeout
+func (r *BulkRequest) WithTimeout(timeout time.Duration) *BulkRequest {
+	r.Timeout = timeout
+	return r
+}
+
+// WithWaitForActiveShards sets the number of active shard copies required before performing the request
+func (r *BulkRequest) WithWaitForActiveShards(waitFor string) *BulkRequest {
+	r.WaitForActiveShards = waitFor
+	return r
+}
+
+// WithRequireAlias sets whether the target index of all actions must be an alias
+func (r *BulkRequest) WithRequireAlias(requireAlias bool) *BulkRequest {
+	r.RequireAlias = requireAlias
+	return r
+}
+
+// WithDataStream sets whether the Index of the request is a data stream
+func (r *BulkRequest) WithDataStream(dataStream bool) *BulkRequest {
+	r.DataStream = dataStream
+	return r
+}
+
+// WithSource sets the source filtering of the documents returned by update actions
+func (r *BulkRequest) WithSource(source ...string) *BulkRequest {
+	r.Source = source
+	return r
+}
+
+// WithSourceIncludes sets the source fields to be returned by update actions
+func (r *BulkRequest) WithSourceIncludes(includes ...string) *BulkRequest {
+	r.SourceIncludes = includes
+	return r
+}
+
+// WithSourceExcludes sets the source fields to be excluded by update actions
+func (r *BulkRequest) WithSourceExcludes(excludes ...string) *BulkRequest {
+	r.SourceExcludes = excludes
+	return r
+}
+
+// WithStreaming sets whether the request body is encoded while it is sent and the response is decoded as it is read.
+// The request body is only streamed if the opensearch.Client is built with DisableRetry.
+func (r *BulkRequest) WithStreaming(streaming bool) *BulkRequest {
+	r.Streaming = streaming
+	return r
+}
+
 // BulkResponse is a domain model union response type for BulkRequest for all supported OpenSearch versions.
 // Currently supported versions are:
 //   - OpenSearch 2
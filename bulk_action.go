@@ -3,6 +3,7 @@ package opensearchtools
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 )
 
 // BulkActionType is an enum for the various BulkActionTypes.
@@ -35,11 +36,47 @@ const (
 //   - NewCreateBulkAction
 //   - NewDeleteBulkAction
 //   - NewUpdateBulkAction
+//   - NewUpdateRequestBulkAction
 //
 // For more details, see https://opensearch.org/docs/latest/api-reference/document-apis/bulk/#request-body
+//
+// The optional action metadata fields are only applicable to certain BulkActionTypes,
+// version-specific executors validate that they are used with a supported BulkActionType.
 type BulkAction struct {
 	Type BulkActionType
 	Doc  RoutableDoc
+
+	// Update is only used by BulkUpdate actions. When set, the update body is marshaled in place of the Doc.
+	Update *UpdateRequest
+
+	// Routing value used to route the action to a specific shard
+	Routing string
+
+	// Version of the document for versioned BulkIndex and BulkDelete actions. Omitted if zero.
+	Version uint64
+
+	// VersionType determines how the Version is compared, such as "external" or "external_gte"
+	VersionType string
+
+	// IfSeqNo only performs the action if the document has this sequence number
+	IfSeqNo uint64
+
+	// IfPrimaryTerm only performs the action if the document has this primary term.
+	// Optimistic concurrency control is only applied when IfPrimaryTerm is greater than zero.
+	IfPrimaryTerm uint64
+
+	// Pipeline is the ingest pipeline used to preprocess the document of BulkIndex and BulkCreate actions
+	Pipeline string
+
+	// RequireAlias requires the target index of the action to be an alias
+	RequireAlias bool
+
+	// DataStream marks the target index of the action as a data stream, which only accepts BulkCreate actions.
+	// It is only used for validation and is not sent to OpenSearch.
+	DataStream bool
+
+	// RetryOnConflict is the number of times to retry a BulkUpdate action if a version conflict occurs
+	RetryOnConflict int
 }
 
 // NewCreateBulkAction instantiates a BulkCreate action.
@@ -66,6 +103,22 @@ func NewUpdateBulkAction(doc RoutableDoc) BulkAction {
 	}
 }
 
+// NewUpdateRequestBulkAction instantiates a BulkUpdate action from an [UpdateRequest].
+// Unlike NewUpdateBulkAction, the update is enveloped with its partial doc, upsert, and script,
+// and the routing, retry on conflict, and optimistic concurrency settings are applied to the action.
+// [UpdateRequest.Refresh] is ignored, as refresh is set at the [BulkRequest] level.
+func NewUpdateRequestBulkAction(req *UpdateRequest) BulkAction {
+	return BulkAction{
+		Type:            BulkUpdate,
+		Doc:             NewDocumentRef(req.Index, req.ID),
+		Update:          req,
+		Routing:         req.Routing,
+		RetryOnConflict: req.RetryOnConflict,
+		IfSeqNo:         req.IfSeqNo,
+		IfPrimaryTerm:   req.IfPrimaryTerm,
+	}
+}
+
 // NewDeleteBulkAction instantiates a BulkDelete action.
 func NewDeleteBulkAction(index, id string) BulkAction {
 	return BulkAction{
@@ -74,6 +127,50 @@ func NewDeleteBulkAction(index, id string) BulkAction {
 	}
 }
 
+// WithRouting sets the routing value of the action
+func (b BulkAction) WithRouting(routing string) BulkAction {
+	b.Routing = routing
+	return b
+}
+
+// WithVersion sets the version and version type of the action
+func (b BulkAction) WithVersion(version uint64, versionType string) BulkAction {
+	b.Version = version
+	b.VersionType = versionType
+	return b
+}
+
+// WithIfSeqNoPrimaryTerm only performs the action if the document has the provided sequence number and primary term
+func (b BulkAction) WithIfSeqNoPrimaryTerm(seqNo, primaryTerm uint64) BulkAction {
+	b.IfSeqNo = seqNo
+	b.IfPrimaryTerm = primaryTerm
+	return b
+}
+
+// WithPipeline sets the ingest pipeline of the action
+func (b BulkAction) WithPipeline(pipeline string) BulkAction {
+	b.Pipeline = pipeline
+	return b
+}
+
+// WithRequireAlias sets whether the target index of the action must be an alias
+func (b BulkAction) WithRequireAlias(requireAlias bool) BulkAction {
+	b.RequireAlias = requireAlias
+	return b
+}
+
+// WithDataStream sets whether the target index of the action is a data stream
+func (b BulkAction) WithDataStream(dataStream bool) BulkAction {
+	b.DataStream = dataStream
+	return b
+}
+
+// WithRetryOnConflict sets the number of times to retry the action on a version conflict
+func (b BulkAction) WithRetryOnConflict(retries int) BulkAction {
+	b.RetryOnConflict = retries
+	return b
+}
+
 // MarshalJSONLines marshals the BulkAction into the appropriate JSON lines depending on the BulkActionType.
 func (b *BulkAction) MarshalJSONLines() ([][]byte, error) {
 	if b.Doc == nil {
@@ -93,22 +190,57 @@ func (b *BulkAction) MarshalJSONLines() ([][]byte, error) {
 		actionRouting["_index"] = b.Doc.Index()
 	}
 
+	if b.Routing != "" {
+		actionRouting["routing"] = b.Routing
+	}
+
+	if b.Version > 0 {
+		actionRouting["version"] = b.Version
+	}
+
+	if b.VersionType != "" {
+		actionRouting["version_type"] = b.VersionType
+	}
+
+	if b.IfPrimaryTerm > 0 {
+		actionRouting["if_seq_no"] = b.IfSeqNo
+		actionRouting["if_primary_term"] = b.IfPrimaryTerm
+	}
+
+	if b.Pipeline != "" {
+		actionRouting["pipeline"] = b.Pipeline
+	}
+
+	if b.RequireAlias {
+		actionRouting["require_alias"] = true
+	}
+
+	if b.RetryOnConflict > 0 {
+		actionRouting["retry_on_conflict"] = b.RetryOnConflict
+	}
+
 	actionMeta := make(map[string]any)
 	switch b.Type {
 	case BulkCreate, BulkIndex, BulkUpdate:
-		actionMeta[string(b.Type)] = actionRouting
 		var (
 			line []byte
 			jErr error
 		)
 
+		actionMeta[string(b.Type)] = actionRouting
 		if line, jErr = json.Marshal(actionMeta); jErr != nil {
 			return nil, jErr
 		}
 
 		jsonLines = append(jsonLines, line)
 
-		if line, jErr = json.Marshal(b.Doc); jErr != nil {
+		if b.Type == BulkUpdate && b.Update != nil {
+			line, jErr = b.Update.Body.ToOpenSearchJSON()
+		} else {
+			line, jErr = json.Marshal(b.Doc)
+		}
+
+		if jErr != nil {
 			return nil, jErr
 		}
 
@@ -201,6 +333,20 @@ func (o *ActionResponse) UnmarshalJSON(m []byte) error {
 	return fmt.Errorf("empty bulk action response returned")
 }
 
+// Failed returns true if the action failed, either with an [ActionError] or a non-2xx status.
+// A BulkDelete of a missing document responds with a 404 and a not_found Result without an error, which isn't a failure.
+func (o ActionResponse) Failed() bool {
+	if o.Error != nil {
+		return true
+	}
+
+	if o.Type == string(BulkDelete) && o.Status == http.StatusNotFound {
+		return false
+	}
+
+	return o.Status > 299
+}
+
 func readField[T any, P PtrTo[T]](destination P, field string, attributes map[string]json.RawMessage) error {
 	if value, exists := attributes[field]; exists {
 		if err := json.Unmarshal(value, destination); err != nil {
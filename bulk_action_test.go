@@ -2,6 +2,7 @@ package opensearchtools
 
 import (
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -87,6 +88,46 @@ func TestActionResponse_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestActionResponse_Failed(t *testing.T) {
+	tests := []struct {
+		name string
+		resp ActionResponse
+		want bool
+	}{
+		{
+			name: "Created",
+			resp: ActionResponse{Type: string(BulkIndex), Status: http.StatusCreated, Result: "created"},
+			want: false,
+		},
+		{
+			name: "Delete of a missing document",
+			resp: ActionResponse{Type: string(BulkDelete), Status: http.StatusNotFound, Result: "not_found"},
+			want: false,
+		},
+		{
+			name: "Delete of a missing index",
+			resp: ActionResponse{Type: string(BulkDelete), Status: http.StatusNotFound, Error: &ActionError{Type: "index_not_found_exception"}},
+			want: true,
+		},
+		{
+			name: "Update of a missing document",
+			resp: ActionResponse{Type: string(BulkUpdate), Status: http.StatusNotFound},
+			want: true,
+		},
+		{
+			name: "Error with a success status",
+			resp: ActionResponse{Type: string(BulkIndex), Status: http.StatusOK, Error: &ActionError{Type: "error"}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.resp.Failed())
+		})
+	}
+}
+
 func TestBulkAction_MarshalJSONLines_BulkCreateAction(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -282,6 +323,131 @@ func TestBulkAction_MarshalJSONLines_BulkUpdateAction(t *testing.T) {
 	}
 }
 
+func TestBulkAction_MarshalJSONLines_UpdateRequestBulkAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *UpdateRequest
+		want    [][]byte
+		wantErr bool
+	}{
+		{
+			name: "Partial doc",
+			req: NewUpdateRequest("index", "id").
+				WithDoc(map[string]any{"other_field": 1}),
+			want: [][]byte{
+				[]byte(`{"update":{"_id":"id","_index":"index"}}`),
+				[]byte(`{"doc":{"other_field":1}}`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Partial doc as upsert with routing and retries",
+			req: NewUpdateRequest("index", "id").
+				WithDoc(map[string]any{"other_field": 1}).
+				WithDocAsUpsert(true).
+				WithRouting("route").
+				WithRetryOnConflict(3).
+				WithFetchSource(true),
+			want: [][]byte{
+				[]byte(`{"update":{"_id":"id","_index":"index","routing":"route","retry_on_conflict":3}}`),
+				[]byte(`{"doc":{"other_field":1},"doc_as_upsert":true,"_source":true}`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Script with upsert and optimistic concurrency",
+			req: NewUpdateRequest("index", "id").
+				WithScript(NewScript("ctx._source.other_field += params.inc").AddParam("inc", 1)).
+				WithUpsert(map[string]any{"other_field": 1}).
+				WithIfSeqNoPrimaryTerm(0, 1),
+			want: [][]byte{
+				[]byte(`{"update":{"_id":"id","_index":"index","if_seq_no":0,"if_primary_term":1}}`),
+				[]byte(`{"script":{"source":"ctx._source.other_field += params.inc","params":{"inc":1}},"upsert":{"other_field":1}}`),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Missing doc and script fails",
+			req:     NewUpdateRequest("index", "id"),
+			wantErr: true,
+		},
+		{
+			name: "Doc missing ID fails",
+			req: NewUpdateRequest("index", "").
+				WithDoc(map[string]any{"other_field": 1}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewUpdateRequestBulkAction(tt.req)
+
+			jsonLines, err := c.MarshalJSONLines()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSONLines() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				require.Nil(t, jsonLines, "MarshalJSONLines should return nil if errored")
+			} else {
+				require.Lenf(t, jsonLines, len(tt.want), "wanted %d lines", len(tt.want))
+				for i, want := range tt.want {
+					require.JSONEq(t, string(want), string(jsonLines[i]))
+				}
+			}
+		})
+	}
+}
+
+func TestBulkAction_MarshalJSONLines_ActionMetadata(t *testing.T) {
+	testDoc := bulkTestDoc{index: "index", id: "id", OtherField: 1}
+
+	tests := []struct {
+		name   string
+		action BulkAction
+		want   string
+	}{
+		{
+			name:   "Routing",
+			action: NewIndexBulkAction(testDoc).WithRouting("route"),
+			want:   `{"index":{"_id":"id","_index":"index","routing":"route"}}`,
+		},
+		{
+			name:   "External version",
+			action: NewIndexBulkAction(testDoc).WithVersion(5, "external"),
+			want:   `{"index":{"_id":"id","_index":"index","version":5,"version_type":"external"}}`,
+		},
+		{
+			name:   "Optimistic concurrency",
+			action: NewDeleteBulkAction("index", "id").WithIfSeqNoPrimaryTerm(0, 1),
+			want:   `{"delete":{"_id":"id","_index":"index","if_seq_no":0,"if_primary_term":1}}`,
+		},
+		{
+			name:   "Zero primary term is omitted",
+			action: NewDeleteBulkAction("index", "id").WithIfSeqNoPrimaryTerm(3, 0),
+			want:   `{"delete":{"_id":"id","_index":"index"}}`,
+		},
+		{
+			name:   "Pipeline and require alias",
+			action: NewCreateBulkAction(testDoc).WithPipeline("pipeline").WithRequireAlias(true),
+			want:   `{"create":{"_id":"id","_index":"index","pipeline":"pipeline","require_alias":true}}`,
+		},
+		{
+			name:   "Retry on conflict",
+			action: NewUpdateBulkAction(testDoc).WithRetryOnConflict(2),
+			want:   `{"update":{"_id":"id","_index":"index","retry_on_conflict":2}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonLines, err := tt.action.MarshalJSONLines()
+			require.Nil(t, err)
+			require.JSONEq(t, tt.want, string(jsonLines[0]))
+		})
+	}
+}
+
 func TestBulkAction_MarshalJSONLines_BulkDeleteAction(t *testing.T) {
 	type fields struct {
 		index string
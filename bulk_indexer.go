@@ -0,0 +1,349 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultBulkIndexerFlushActions is the number of buffered actions which triggers a flush
+	DefaultBulkIndexerFlushActions = 1000
+
+	// DefaultBulkIndexerFlushBytes is the size in bytes of the buffered actions which triggers a flush
+	DefaultBulkIndexerFlushBytes = 5 * 1024 * 1024
+
+	// DefaultBulkIndexerFlushInterval is the maximum amount of time actions are buffered before being flushed
+	DefaultBulkIndexerFlushInterval = 30 * time.Second
+)
+
+// BulkIndexerConfig configures a [BulkIndexer].
+// Zero values for NumWorkers, FlushActions, FlushBytes, and FlushInterval are replaced by their defaults.
+type BulkIndexerConfig struct {
+	// Client executes the [BulkRequest]s, typically a version-specific executor such as [opensearchtools/osv2.Executor]
+	Client Bulk
+
+	// Context is the base context of every flush, canceling it stops in-flight and subsequent flushes.
+	// Defaults to [context.Background].
+	Context context.Context
+
+	// FlushTimeout bounds the execution of each flushed [BulkRequest]. No timeout if zero.
+	FlushTimeout time.Duration
+
+	// Index is the request level index for actions which do not specify one
+	Index string
+
+	// Refresh determines if each flushed request should wait for a refresh or not
+	Refresh Refresh
+
+	// Pipeline is the ingest pipeline used to preprocess the documents of each flushed request
+	Pipeline string
+
+	// NumWorkers is the number of flushes that can run in parallel. Defaults to [runtime.NumCPU].
+	NumWorkers int
+
+	// FlushActions is the number of buffered actions which triggers a flush
+	FlushActions int
+
+	// FlushBytes is the size in bytes of the marshaled actions which triggers a flush
+	FlushBytes int
+
+	// FlushInterval is the maximum amount of time actions are buffered before being flushed
+	FlushInterval time.Duration
+
+	// OnError is called when an entire [BulkRequest] fails to execute
+	OnError func(ctx context.Context, err error)
+}
+
+// BulkIndexerItem is a [BulkAction] to be added to a [BulkIndexer] along with callbacks for its outcome.
+type BulkIndexerItem struct {
+	// Action to be performed
+	Action BulkAction
+
+	// OnSuccess is called with the original action and its response if the action succeeded
+	OnSuccess func(ctx context.Context, action BulkAction, resp ActionResponse)
+
+	// OnFailure is called with the original action if the action failed.
+	// If the entire request failed, resp is empty and err is non-nil, otherwise resp contains the [ActionError].
+	OnFailure func(ctx context.Context, action BulkAction, resp ActionResponse, err error)
+}
+
+// BulkIndexerStats are the counters of a [BulkIndexer].
+type BulkIndexerStats struct {
+	// NumAdded is the number of actions added to the indexer
+	NumAdded uint64
+
+	// NumFlushed is the number of actions sent to OpenSearch
+	NumFlushed uint64
+
+	// NumSucceeded is the number of actions that succeeded
+	NumSucceeded uint64
+
+	// NumFailed is the number of actions that failed
+	NumFailed uint64
+
+	// NumRequests is the number of [BulkRequest]s executed
+	NumRequests uint64
+}
+
+// BulkIndexer accumulates [BulkAction]s from any number of goroutines and executes them in batches through a [Bulk] client.
+// A batch is flushed when it reaches [BulkIndexerConfig.FlushActions] actions, [BulkIndexerConfig.FlushBytes] bytes of
+// marshaled actions, or when [BulkIndexerConfig.FlushInterval] has elapsed.
+// Each worker buffers and flushes its own batch, so up to [BulkIndexerConfig.NumWorkers] flushes run in parallel.
+//
+//	indexer, err := NewBulkIndexer(BulkIndexerConfig{Client: osv2Executor, Index: "example_index"})
+//	err = indexer.Add(ctx, BulkIndexerItem{Action: NewIndexBulkAction(doc)})
+//	err = indexer.Close(ctx)
+//
+// A BulkIndexer must be closed to flush any remaining actions.
+type BulkIndexer struct {
+	config BulkIndexerConfig
+	queue  chan bulkIndexerEntry
+	wg     sync.WaitGroup
+
+	// ctx is derived from the configured Context and canceled by Close, cancelling any in-flight flush
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.RWMutex
+	closed bool
+
+	numAdded     atomic.Uint64
+	numFlushed   atomic.Uint64
+	numSucceeded atomic.Uint64
+	numFailed    atomic.Uint64
+	numRequests  atomic.Uint64
+}
+
+// bulkIndexerEntry is a queued item along with its marshaled size.
+type bulkIndexerEntry struct {
+	item BulkIndexerItem
+	size int
+}
+
+// NewBulkIndexer instantiates a BulkIndexer and starts its workers.
+// An error is returned if the config has no Client.
+func NewBulkIndexer(config BulkIndexerConfig) (*BulkIndexer, error) {
+	if config.Client == nil {
+		return nil, fmt.Errorf("a BulkIndexer requires a Bulk client")
+	}
+
+	if config.NumWorkers <= 0 {
+		config.NumWorkers = runtime.NumCPU()
+	}
+
+	if config.FlushActions <= 0 {
+		config.FlushActions = DefaultBulkIndexerFlushActions
+	}
+
+	if config.FlushBytes <= 0 {
+		config.FlushBytes = DefaultBulkIndexerFlushBytes
+	}
+
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultBulkIndexerFlushInterval
+	}
+
+	if config.Context == nil {
+		config.Context = context.Background()
+	}
+
+	ctx, cancel := context.WithCancel(config.Context)
+	bi := &BulkIndexer{
+		config: config,
+		queue:  make(chan bulkIndexerEntry, config.NumWorkers),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	for i := 0; i < config.NumWorkers; i++ {
+		bi.wg.Add(1)
+		go bi.work()
+	}
+
+	return bi, nil
+}
+
+// Add an item to the BulkIndexer. The action is marshaled immediately to account for its size,
+// an error is returned if it cannot be marshaled, the indexer is closed, or ctx is done before the item is queued.
+func (bi *BulkIndexer) Add(ctx context.Context, item BulkIndexerItem) error {
+	jsonLines, jErr := item.Action.MarshalJSONLines()
+	if jErr != nil {
+		return jErr
+	}
+
+	size := 0
+	for _, line := range jsonLines {
+		size += len(line) + 1
+	}
+
+	bi.mu.RLock()
+	defer bi.mu.RUnlock()
+
+	if bi.closed {
+		return fmt.Errorf("cannot add an action to a closed BulkIndexer")
+	}
+
+	select {
+	case bi.queue <- bulkIndexerEntry{item: item, size: size}:
+		bi.numAdded.Add(1)
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting new items and waits for all buffered items to be flushed.
+// If ctx is done before the flushes complete, the in-flight flushes are canceled and ctx's error is returned
+// without waiting for the canceled flushes to report their failures.
+func (bi *BulkIndexer) Close(ctx context.Context) error {
+	bi.mu.Lock()
+	if !bi.closed {
+		bi.closed = true
+		close(bi.queue)
+	}
+	bi.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		bi.wg.Wait()
+		close(done)
+	}()
+
+	defer bi.cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stats returns the current counters of the BulkIndexer.
+func (bi *BulkIndexer) Stats() BulkIndexerStats {
+	return BulkIndexerStats{
+		NumAdded:     bi.numAdded.Load(),
+		NumFlushed:   bi.numFlushed.Load(),
+		NumSucceeded: bi.numSucceeded.Load(),
+		NumFailed:    bi.numFailed.Load(),
+		NumRequests:  bi.numRequests.Load(),
+	}
+}
+
+// work buffers items from the queue and flushes them once a threshold is reached, until the queue is closed.
+func (bi *BulkIndexer) work() {
+	defer bi.wg.Done()
+
+	ticker := time.NewTicker(bi.config.FlushInterval)
+	defer ticker.Stop()
+
+	var (
+		items []BulkIndexerItem
+		bytes int
+	)
+
+	flush := func() {
+		if len(items) > 0 {
+			bi.flush(items)
+		}
+
+		items = nil
+		bytes = 0
+	}
+
+	for {
+		select {
+		case entry, ok := <-bi.queue:
+			if !ok {
+				flush()
+				return
+			}
+
+			// flush before exceeding the byte threshold, so a request only exceeds it with a single oversized action
+			if len(items) > 0 && bytes+entry.size > bi.config.FlushBytes {
+				flush()
+			}
+
+			items = append(items, entry.item)
+			bytes += entry.size
+
+			if len(items) >= bi.config.FlushActions || bytes >= bi.config.FlushBytes {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// flush executes a BulkRequest for the items and reports the outcome of each item to its callbacks.
+func (bi *BulkIndexer) flush(items []BulkIndexerItem) {
+	ctx := bi.ctx
+	if bi.config.FlushTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, bi.config.FlushTimeout)
+		defer cancel()
+	}
+
+	req := NewBulkRequest().WithIndex(bi.config.Index).WithPipeline(bi.config.Pipeline)
+	req.Refresh = bi.config.Refresh
+	for _, item := range items {
+		req.Add(item.Action)
+	}
+
+	bi.numRequests.Add(1)
+	bi.numFlushed.Add(uint64(len(items)))
+
+	resp, err := bi.config.Client.Bulk(ctx, req)
+	if err == nil {
+		err = bulkResponseError(resp.Response, len(items))
+	}
+
+	if err != nil {
+		if bi.config.OnError != nil {
+			bi.config.OnError(ctx, err)
+		}
+
+		bi.numFailed.Add(uint64(len(items)))
+		for _, item := range items {
+			if item.OnFailure != nil {
+				item.OnFailure(ctx, item.Action, ActionResponse{}, err)
+			}
+		}
+
+		return
+	}
+
+	for i, item := range items {
+		actionResp := resp.Response.Items[i]
+		if actionResp.Failed() {
+			bi.numFailed.Add(1)
+			if item.OnFailure != nil {
+				item.OnFailure(ctx, item.Action, actionResp, nil)
+			}
+		} else {
+			bi.numSucceeded.Add(1)
+			if item.OnSuccess != nil {
+				item.OnSuccess(ctx, item.Action, actionResp)
+			}
+		}
+	}
+}
+
+// bulkResponseError returns an error if the BulkResponse failed as a whole or
+// if it can't be aligned with the number of actions sent.
+func bulkResponseError(resp BulkResponse, numActions int) error {
+	if resp.Error != nil {
+		return fmt.Errorf("bulk request failed: %s: %s", resp.Error.Type, resp.Error.Reason)
+	}
+
+	if len(resp.Items) != numActions {
+		return fmt.Errorf("bulk response contains %d items for %d actions", len(resp.Items), numActions)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBulk records the requests it receives and responds with a successful item for each action,
+// unless the action's document ID is listed in failIDs.
+type fakeBulk struct {
+	mu       sync.Mutex
+	requests []*BulkRequest
+	failIDs  map[string]bool
+	err      error
+}
+
+func (f *fakeBulk) Bulk(_ context.Context, req *BulkRequest) (OpenSearchResponse[BulkResponse], error) {
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	f.mu.Unlock()
+
+	if f.err != nil {
+		return OpenSearchResponse[BulkResponse]{}, f.err
+	}
+
+	var resp BulkResponse
+	for _, a := range req.Actions {
+		item := ActionResponse{Type: string(a.Type), ID: a.Doc.ID(), Status: http.StatusCreated}
+		if f.failIDs[a.Doc.ID()] {
+			resp.Errors = true
+			item.Status = http.StatusBadRequest
+			item.Error = &ActionError{Type: "mapper_parsing_exception"}
+		}
+
+		resp.Items = append(resp.Items, item)
+	}
+
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, resp), nil
+}
+
+func (f *fakeBulk) numRequests() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.requests)
+}
+
+func TestNewBulkIndexer_RequiresClient(t *testing.T) {
+	_, err := NewBulkIndexer(BulkIndexerConfig{})
+	require.NotNil(t, err)
+}
+
+func TestBulkIndexer_ConcurrentAdd(t *testing.T) {
+	client := &fakeBulk{failIDs: map[string]bool{"7": true, "42": true}}
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{
+		Client:       client,
+		Index:        testIndex1,
+		NumWorkers:   4,
+		FlushActions: 10,
+	})
+	require.Nil(t, err)
+
+	var (
+		mu        sync.Mutex
+		succeeded = make(map[string]bool)
+		failed    = make(map[string]bool)
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			addErr := indexer.Add(context.Background(), BulkIndexerItem{
+				Action: NewIndexBulkAction(NewDocumentRef("", id)),
+				OnSuccess: func(_ context.Context, action BulkAction, _ ActionResponse) {
+					mu.Lock()
+					succeeded[action.Doc.ID()] = true
+					mu.Unlock()
+				},
+				OnFailure: func(_ context.Context, action BulkAction, resp ActionResponse, err error) {
+					mu.Lock()
+					failed[action.Doc.ID()] = err == nil && resp.Error != nil
+					mu.Unlock()
+				},
+			})
+			if addErr != nil {
+				t.Errorf("unexpected error adding action: %v", addErr)
+			}
+		}(fmt.Sprint(i))
+	}
+
+	wg.Wait()
+	require.Nil(t, indexer.Close(context.Background()))
+
+	require.Len(t, succeeded, 98)
+	require.Equal(t, map[string]bool{"7": true, "42": true}, failed)
+
+	stats := indexer.Stats()
+	require.Equal(t, uint64(100), stats.NumAdded)
+	require.Equal(t, uint64(100), stats.NumFlushed)
+	require.Equal(t, uint64(98), stats.NumSucceeded)
+	require.Equal(t, uint64(2), stats.NumFailed)
+	require.Equal(t, uint64(client.numRequests()), stats.NumRequests)
+
+	for _, req := range client.requests {
+		require.Equal(t, testIndex1, req.Index)
+		require.LessOrEqual(t, len(req.Actions), 10)
+	}
+}
+
+func TestBulkIndexer_FlushBytes(t *testing.T) {
+	client := &fakeBulk{}
+	action := NewIndexBulkAction(NewDocumentRef(testIndex1, testID1))
+	jsonLines, _ := action.MarshalJSONLines()
+	actionSize := len(jsonLines[0]) + len(jsonLines[1]) + 2
+
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{
+		Client:     client,
+		NumWorkers: 1,
+		FlushBytes: actionSize * 3,
+	})
+	require.Nil(t, err)
+
+	for i := 0; i < 7; i++ {
+		require.Nil(t, indexer.Add(context.Background(), BulkIndexerItem{Action: action}))
+	}
+
+	require.Nil(t, indexer.Close(context.Background()))
+	require.Equal(t, 3, client.numRequests())
+	require.Len(t, client.requests[0].Actions, 3)
+	require.Len(t, client.requests[1].Actions, 3)
+	require.Len(t, client.requests[2].Actions, 1)
+}
+
+func TestBulkIndexer_FlushInterval(t *testing.T) {
+	client := &fakeBulk{}
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{
+		Client:        client,
+		NumWorkers:    1,
+		FlushInterval: 10 * time.Millisecond,
+	})
+	require.Nil(t, err)
+
+	flushed := make(chan struct{})
+	require.Nil(t, indexer.Add(context.Background(), BulkIndexerItem{
+		Action: NewIndexBulkAction(NewDocumentRef(testIndex1, testID1)),
+		OnSuccess: func(context.Context, BulkAction, ActionResponse) {
+			close(flushed)
+		},
+	}))
+
+	select {
+	case <-flushed:
+	case <-time.After(time.Second):
+		t.Fatal("action was not flushed on the interval")
+	}
+
+	require.Nil(t, indexer.Close(context.Background()))
+}
+
+func TestBulkIndexer_RequestError(t *testing.T) {
+	client := &fakeBulk{err: fmt.Errorf("connection refused")}
+
+	var onErrorCalls int
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{
+		Client:     client,
+		NumWorkers: 1,
+		OnError: func(context.Context, error) {
+			onErrorCalls++
+		},
+	})
+	require.Nil(t, err)
+
+	var failures []error
+	for i := 0; i < 3; i++ {
+		require.Nil(t, indexer.Add(context.Background(), BulkIndexerItem{
+			Action: NewIndexBulkAction(NewDocumentRef(testIndex1, fmt.Sprint(i))),
+			OnFailure: func(_ context.Context, _ BulkAction, _ ActionResponse, err error) {
+				failures = append(failures, err)
+			},
+		}))
+	}
+
+	require.Nil(t, indexer.Close(context.Background()))
+	require.Equal(t, 1, onErrorCalls)
+	require.Len(t, failures, 3)
+	require.Equal(t, uint64(3), indexer.Stats().NumFailed)
+}
+
+// slowBulk blocks every request until its context is done, signaling on started when a request begins
+type slowBulk struct {
+	started chan struct{}
+}
+
+func (s *slowBulk) Bulk(ctx context.Context, _ *BulkRequest) (OpenSearchResponse[BulkResponse], error) {
+	s.started <- struct{}{}
+	<-ctx.Done()
+	return OpenSearchResponse[BulkResponse]{}, ctx.Err()
+}
+
+func TestBulkIndexer_FlushTimeout(t *testing.T) {
+	client := &slowBulk{started: make(chan struct{}, 1)}
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{
+		Client:       client,
+		NumWorkers:   1,
+		FlushTimeout: 10 * time.Millisecond,
+	})
+	require.Nil(t, err)
+
+	var failure error
+	require.Nil(t, indexer.Add(context.Background(), BulkIndexerItem{
+		Action: NewIndexBulkAction(NewDocumentRef(testIndex1, testID1)),
+		OnFailure: func(_ context.Context, _ BulkAction, _ ActionResponse, err error) {
+			failure = err
+		},
+	}))
+
+	require.Nil(t, indexer.Close(context.Background()))
+	require.ErrorIs(t, failure, context.DeadlineExceeded)
+	require.Equal(t, uint64(1), indexer.Stats().NumFailed)
+}
+
+func TestBulkIndexer_CloseCancelsFlush(t *testing.T) {
+	client := &slowBulk{started: make(chan struct{}, 1)}
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{Client: client, NumWorkers: 1})
+	require.Nil(t, err)
+
+	failed := make(chan error, 1)
+	require.Nil(t, indexer.Add(context.Background(), BulkIndexerItem{
+		Action: NewIndexBulkAction(NewDocumentRef(testIndex1, testID1)),
+		OnFailure: func(_ context.Context, _ BulkAction, _ ActionResponse, err error) {
+			failed <- err
+		},
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, indexer.Close(ctx), context.DeadlineExceeded)
+
+	select {
+	case err := <-failed:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("flush was not canceled by Close")
+	}
+}
+
+func TestBulkIndexer_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &slowBulk{started: make(chan struct{}, 1)}
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{Client: client, NumWorkers: 1, Context: ctx})
+	require.Nil(t, err)
+
+	var failure error
+	require.Nil(t, indexer.Add(context.Background(), BulkIndexerItem{
+		Action: NewIndexBulkAction(NewDocumentRef(testIndex1, testID1)),
+		OnFailure: func(_ context.Context, _ BulkAction, _ ActionResponse, err error) {
+			failure = err
+		},
+	}))
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- indexer.Close(context.Background())
+	}()
+
+	<-client.started
+	cancel()
+	require.Nil(t, <-closed)
+	require.ErrorIs(t, failure, context.Canceled)
+}
+
+func TestBulkIndexer_AddAfterClose(t *testing.T) {
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{Client: &fakeBulk{}})
+	require.Nil(t, err)
+	require.Nil(t, indexer.Close(context.Background()))
+
+	addErr := indexer.Add(context.Background(), BulkIndexerItem{Action: NewIndexBulkAction(NewDocumentRef(testIndex1, testID1))})
+	require.NotNil(t, addErr)
+}
+
+func TestBulkIndexer_AddInvalidAction(t *testing.T) {
+	indexer, err := NewBulkIndexer(BulkIndexerConfig{Client: &fakeBulk{}})
+	require.Nil(t, err)
+
+	addErr := indexer.Add(context.Background(), BulkIndexerItem{Action: NewIndexBulkAction(NewDocumentRef(testIndex1, ""))})
+	require.NotNil(t, addErr)
+	require.Nil(t, indexer.Close(context.Background()))
+	require.Equal(t, uint64(0), indexer.Stats().NumAdded)
+}
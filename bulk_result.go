@@ -0,0 +1,117 @@
+package opensearchtools
+
+// BulkActionResult pairs a [BulkAction] with the [ActionResponse] OpenSearch returned for it.
+type BulkActionResult struct {
+	Action   BulkAction
+	Response ActionResponse
+}
+
+// Doc returns the [RoutableDoc] of the originating action
+func (r BulkActionResult) Doc() RoutableDoc {
+	return r.Action.Doc
+}
+
+// Failed returns true if the action failed
+func (r BulkActionResult) Failed() bool {
+	return r.Response.Failed()
+}
+
+// BulkResults correlates every [BulkAction] of a [BulkRequest] with its [ActionResponse], in request order.
+// It can be used to route failed actions, along with their original documents, to a retry or dead-letter queue:
+//
+//	results, err := NewBulkResults(bulkReq, bulkResp.Response)
+//	for _, failure := range results.Failed() {
+//		deadLetters.Publish(failure.Doc(), failure.Response.Error)
+//	}
+type BulkResults []BulkActionResult
+
+// NewBulkResults pairs each action of the request with the item at the same position in the response.
+// If the request was sent with ParseResponseItemsOnlyOnFailure and no errors occurred, each action is paired with
+// an empty, successful ActionResponse.
+// An error is returned if the response failed as a whole or its items can't be aligned with the actions of the request.
+func NewBulkResults(req *BulkRequest, resp BulkResponse) (BulkResults, error) {
+	if len(resp.Items) == 0 && !resp.Errors && resp.Error == nil {
+		results := make(BulkResults, len(req.Actions))
+		for i, action := range req.Actions {
+			results[i] = BulkActionResult{Action: action}
+		}
+
+		return results, nil
+	}
+
+	if err := bulkResponseError(resp, len(req.Actions)); err != nil {
+		return nil, err
+	}
+
+	results := make(BulkResults, len(req.Actions))
+	for i, action := range req.Actions {
+		results[i] = BulkActionResult{
+			Action:   action,
+			Response: resp.Items[i],
+		}
+	}
+
+	return results, nil
+}
+
+// Failed returns the results of the actions which failed
+func (r BulkResults) Failed() BulkResults {
+	return r.filter(func(result BulkActionResult) bool {
+		return result.Failed()
+	})
+}
+
+// Succeeded returns the results of the actions which succeeded
+func (r BulkResults) Succeeded() BulkResults {
+	return r.filter(func(result BulkActionResult) bool {
+		return !result.Failed()
+	})
+}
+
+// ByStatus groups the results by the status of their [ActionResponse]
+func (r BulkResults) ByStatus() map[int]BulkResults {
+	groups := make(map[int]BulkResults)
+	for _, result := range r {
+		groups[result.Response.Status] = append(groups[result.Response.Status], result)
+	}
+
+	return groups
+}
+
+// ByErrorType groups the failed results by the type of their [ActionError], such as "mapper_parsing_exception".
+// Results which failed without an ActionError are grouped under an empty type.
+func (r BulkResults) ByErrorType() map[string]BulkResults {
+	groups := make(map[string]BulkResults)
+	for _, result := range r.Failed() {
+		var errType string
+		if result.Response.Error != nil {
+			errType = result.Response.Error.Type
+		}
+
+		groups[errType] = append(groups[errType], result)
+	}
+
+	return groups
+}
+
+// Actions returns the originating actions of the results
+func (r BulkResults) Actions() []BulkAction {
+	actions := make([]BulkAction, len(r))
+	for i, result := range r {
+		actions[i] = result.Action
+	}
+
+	return actions
+}
+
+// filter returns the results matching the predicate
+func (r BulkResults) filter(predicate func(result BulkActionResult) bool) BulkResults {
+	var filtered BulkResults
+	for _, result := range r {
+		if predicate(result) {
+			filtered = append(filtered, result)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,79 @@
+package opensearchtools
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBulkResults(t *testing.T) {
+	req := NewBulkRequest().Add(
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "1")),
+		NewCreateBulkAction(NewDocumentRef(testIndex1, "2")),
+		NewDeleteBulkAction(testIndex1, "3"),
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "4")),
+	)
+
+	resp := BulkResponse{
+		Errors: true,
+		Items: []ActionResponse{
+			{Type: "index", ID: "1", Status: http.StatusCreated},
+			{Type: "create", ID: "2", Status: http.StatusConflict, Error: &ActionError{Type: "version_conflict_engine_exception"}},
+			{Type: "delete", ID: "3", Status: http.StatusNotFound, Result: "not_found"},
+			{Type: "index", ID: "4", Status: http.StatusBadRequest, Error: &ActionError{Type: "mapper_parsing_exception"}},
+		},
+	}
+
+	results, err := NewBulkResults(req, resp)
+	require.Nil(t, err)
+	require.Len(t, results, 4)
+
+	for i, result := range results {
+		require.Equal(t, req.Actions[i].Doc.ID(), result.Doc().ID())
+		require.Equal(t, result.Doc().ID(), result.Response.ID)
+	}
+
+	// a delete of a missing document isn't a failure
+	failed := results.Failed()
+	require.Len(t, failed, 2)
+	require.Equal(t, []BulkAction{req.Actions[1], req.Actions[3]}, failed.Actions())
+
+	succeeded := results.Succeeded()
+	require.Len(t, succeeded, 2)
+	require.Equal(t, "1", succeeded[0].Doc().ID())
+	require.Equal(t, "3", succeeded[1].Doc().ID())
+
+	byStatus := results.ByStatus()
+	require.Len(t, byStatus, 4)
+	require.Equal(t, "3", byStatus[http.StatusNotFound][0].Doc().ID())
+
+	byErrorType := results.ByErrorType()
+	require.Len(t, byErrorType, 2)
+	require.Equal(t, "2", byErrorType["version_conflict_engine_exception"][0].Doc().ID())
+	require.Equal(t, "4", byErrorType["mapper_parsing_exception"][0].Doc().ID())
+}
+
+func TestNewBulkResults_ItemsOnlyOnFailure(t *testing.T) {
+	req := NewBulkRequest().Add(
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "1")),
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "2")),
+	)
+
+	results, err := NewBulkResults(req, BulkResponse{Took: 3})
+	require.Nil(t, err)
+	require.Len(t, results.Succeeded(), 2)
+}
+
+func TestNewBulkResults_Misaligned(t *testing.T) {
+	req := NewBulkRequest().Add(
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "1")),
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "2")),
+	)
+
+	_, err := NewBulkResults(req, BulkResponse{Errors: true, Items: []ActionResponse{{Status: http.StatusBadRequest}}})
+	require.NotNil(t, err)
+
+	_, err = NewBulkResults(req, BulkResponse{Error: &Error{Type: "illegal_argument_exception"}})
+	require.NotNil(t, err)
+}
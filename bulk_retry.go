@@ -0,0 +1,305 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultBulkRetrierMaxRetries is the number of times failed actions are resent
+	DefaultBulkRetrierMaxRetries = 3
+
+	// DefaultBulkRetrierInitialBackoff is the upper bound of the wait before the first retry
+	DefaultBulkRetrierInitialBackoff = 100 * time.Millisecond
+
+	// DefaultBulkRetrierMaxBackoff is the upper bound of the wait before any retry
+	DefaultBulkRetrierMaxBackoff = 10 * time.Second
+)
+
+// BulkRetrierConfig configures a [BulkRetrier].
+// Zero values for MaxRetries, InitialBackoff, MaxBackoff, and Retryable are replaced by their defaults.
+type BulkRetrierConfig struct {
+	// Client executes the [BulkRequest]s, typically a version-specific executor such as [opensearchtools/osv2.Executor]
+	Client Bulk
+
+	// MaxRetries is the number of times failed actions are resent after the initial request
+	MaxRetries int
+
+	// InitialBackoff is the upper bound of the wait before the first retry, it doubles on every subsequent retry
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the upper bound of the wait before any retry
+	MaxBackoff time.Duration
+
+	// RetryVersionConflicts determines if actions failing with a version conflict are retried by the default Retryable
+	RetryVersionConflicts bool
+
+	// Retryable classifies whether a failed [ActionResponse] should be retried.
+	// Defaults to [IsRetryableActionResponse], along with [IsVersionConflict] if RetryVersionConflicts is set.
+	Retryable func(resp ActionResponse) bool
+}
+
+// BulkRetrier executes [BulkRequest]s through a [Bulk] client and resends the actions which failed with a
+// retryable status, waiting an exponential backoff with full jitter between attempts.
+// BulkRetrier implements [Bulk], so it can be used anywhere a Bulk client is expected, such as a [BulkIndexer]:
+//
+//	retrier, err := NewBulkRetrier(BulkRetrierConfig{Client: osv2Executor})
+//	indexer, err := NewBulkIndexer(BulkIndexerConfig{Client: retrier})
+//
+// To preserve the order of actions on a document, when an action is retried every subsequent action
+// of the request on the same document ID is resent after it, regardless of its own outcome.
+// Actions without a document ID are only resent if they failed themselves.
+// The returned [BulkResponse] contains an [ActionResponse] for every action of the original request,
+// in the original order, holding the outcome of the last attempt of the action.
+type BulkRetrier struct {
+	config BulkRetrierConfig
+}
+
+// NewBulkRetrier instantiates a BulkRetrier.
+// An error is returned if the config has no Client.
+func NewBulkRetrier(config BulkRetrierConfig) (*BulkRetrier, error) {
+	if config.Client == nil {
+		return nil, fmt.Errorf("a BulkRetrier requires a Bulk client")
+	}
+
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = DefaultBulkRetrierMaxRetries
+	}
+
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = DefaultBulkRetrierInitialBackoff
+	}
+
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DefaultBulkRetrierMaxBackoff
+	}
+
+	if config.Retryable == nil {
+		retryVersionConflicts := config.RetryVersionConflicts
+		config.Retryable = func(resp ActionResponse) bool {
+			return IsRetryableActionResponse(resp) || (retryVersionConflicts && IsVersionConflict(resp))
+		}
+	}
+
+	return &BulkRetrier{config: config}, nil
+}
+
+// IsRetryableActionResponse returns true if the action failed because OpenSearch was temporarily
+// unable to process it, such as a rejected execution (429) or an unavailable shard (503).
+func IsRetryableActionResponse(resp ActionResponse) bool {
+	return isRetryableStatus(resp.Status)
+}
+
+// IsVersionConflict returns true if the action failed with a version conflict (409).
+func IsVersionConflict(resp ActionResponse) bool {
+	return resp.Status == http.StatusConflict ||
+		(resp.Error != nil && resp.Error.Type == "version_conflict_engine_exception")
+}
+
+// isRetryableStatus returns true for statuses that indicate OpenSearch was temporarily unable to process a request
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+}
+
+// Bulk executes the request and retries the actions which failed with a retryable status until they succeed,
+// fail with a non-retryable status, or MaxRetries is reached.
+// An attempt rejected as a whole with a retryable status, such as a 429, is retried in its entirety.
+// An error is returned if any attempt fails to execute or ctx is done while waiting to retry, in which case
+// the response holds the outcomes of the attempts made so far.
+func (r *BulkRetrier) Bulk(ctx context.Context, req *BulkRequest) (OpenSearchResponse[BulkResponse], error) {
+	resp, err := r.config.Client.Bulk(ctx, req)
+	if err != nil || !r.shouldRetry(resp, len(req.Actions)) {
+		return resp, err
+	}
+
+	merged := resp
+	merged.Response.Error = nil
+	merged.Response.Items = make([]ActionResponse, len(req.Actions))
+
+	// pending holds the positions in the original request of the actions sent in the latest attempt
+	pending := allPositions(len(req.Actions))
+	if mErr := r.merge(req, merged.Response.Items, pending, resp); mErr != nil {
+		return resp, mErr
+	}
+
+	for attempt := 0; attempt < r.config.MaxRetries; attempt++ {
+		if pending = r.retryPositions(req, merged.Response.Items, pending); len(pending) == 0 {
+			break
+		}
+
+		if wErr := r.wait(ctx, attempt); wErr != nil {
+			return r.finalize(merged), wErr
+		}
+
+		retryReq := *req
+		retryReq.ParseResponseItemsOnlyOnFailure = false
+		retryReq.Actions = make([]BulkAction, len(pending))
+		for i, pos := range pending {
+			retryReq.Actions[i] = req.Actions[pos]
+		}
+
+		retryResp, rErr := r.config.Client.Bulk(ctx, &retryReq)
+		if rErr != nil {
+			return r.finalize(merged), rErr
+		}
+
+		merged.Response.Took += retryResp.Response.Took
+		merged.ValidationResults.Extend(retryResp.ValidationResults)
+
+		if mErr := r.merge(req, merged.Response.Items, pending, retryResp); mErr != nil {
+			return r.finalize(merged), mErr
+		}
+	}
+
+	return r.finalize(merged), nil
+}
+
+// shouldRetry returns true if the initial response contains failures or rejected the entire request with a retryable status
+func (r *BulkRetrier) shouldRetry(resp OpenSearchResponse[BulkResponse], numActions int) bool {
+	if resp.Response.Errors {
+		return true
+	}
+
+	return len(resp.Response.Items) != numActions && isRetryableStatus(resp.StatusCode)
+}
+
+// merge records the outcome of an attempt into items for the attempted positions.
+// If the attempt was rejected as a whole with a retryable status, each attempted action is recorded as rejected,
+// otherwise an error is returned if the response can't be aligned with the attempted actions.
+func (r *BulkRetrier) merge(req *BulkRequest, items []ActionResponse, attempted []int, resp OpenSearchResponse[BulkResponse]) error {
+	if respErr := bulkResponseError(resp.Response, len(attempted)); respErr != nil {
+		if !isRetryableStatus(resp.StatusCode) {
+			return respErr
+		}
+
+		for _, pos := range attempted {
+			items[pos] = rejectedActionResponse(req, req.Actions[pos], resp)
+		}
+
+		return nil
+	}
+
+	for i, pos := range attempted {
+		items[pos] = resp.Response.Items[i]
+	}
+
+	return nil
+}
+
+// rejectedActionResponse builds the ActionResponse of an action whose request was rejected as a whole
+func rejectedActionResponse(req *BulkRequest, action BulkAction, resp OpenSearchResponse[BulkResponse]) ActionResponse {
+	key := newBulkDocKey(req, action)
+	actionResp := ActionResponse{
+		Type:   string(action.Type),
+		Index:  key.index,
+		ID:     key.id,
+		Status: resp.StatusCode,
+		Error:  &ActionError{Index: key.index},
+	}
+
+	if resp.Response.Error != nil {
+		actionResp.Error.Type = resp.Response.Error.Type
+		actionResp.Error.Reason = resp.Response.Error.Reason
+	}
+
+	return actionResp
+}
+
+// retryPositions returns the positions, in order, of the attempted actions which need to be sent again.
+// An action is retried if it failed with a retryable status, or if an earlier action on the same document is retried.
+// Actions without a document ID target distinct documents generated by OpenSearch, so only the failed ones are retried,
+// resending a successful one would create a duplicate document.
+func (r *BulkRetrier) retryPositions(req *BulkRequest, items []ActionResponse, attempted []int) []int {
+	var (
+		positions []int
+		retryDocs = make(map[bulkDocKey]bool)
+	)
+
+	for _, pos := range attempted {
+		key := newBulkDocKey(req, req.Actions[pos])
+		hasID := key.id != ""
+		if (hasID && retryDocs[key]) || r.isRetryable(items[pos]) {
+			if hasID {
+				retryDocs[key] = true
+			}
+
+			positions = append(positions, pos)
+		}
+	}
+
+	return positions
+}
+
+// isRetryable returns true if the action failed and the configured Retryable classifies it as retryable
+func (r *BulkRetrier) isRetryable(resp ActionResponse) bool {
+	return resp.Failed() && r.config.Retryable(resp)
+}
+
+// wait sleeps for a random duration between zero and the exponential backoff of the attempt, or until ctx is done
+func (r *BulkRetrier) wait(ctx context.Context, attempt int) error {
+	// compare against MaxBackoff shifted right, as shifting InitialBackoff left can overflow
+	backoff := r.config.MaxBackoff
+	if attempt < 63 && r.config.InitialBackoff <= r.config.MaxBackoff>>attempt {
+		backoff = r.config.InitialBackoff << attempt
+	}
+
+	timer := time.NewTimer(time.Duration(rand.Int63n(int64(backoff) + 1))) //nolint:gosec // jitter doesn't need a cryptographically secure source
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// finalize recomputes the Errors indicator of the merged response from the final outcome of each action
+func (r *BulkRetrier) finalize(merged OpenSearchResponse[BulkResponse]) OpenSearchResponse[BulkResponse] {
+	merged.Response.Errors = false
+	for _, item := range merged.Response.Items {
+		if item.Failed() {
+			merged.Response.Errors = true
+			break
+		}
+	}
+
+	return merged
+}
+
+// bulkDocKey identifies the document targeted by a BulkAction
+type bulkDocKey struct {
+	index   string
+	id      string
+	routing string
+}
+
+// newBulkDocKey builds the bulkDocKey of the action, falling back on the request level index and routing
+func newBulkDocKey(req *BulkRequest, action BulkAction) bulkDocKey {
+	key := bulkDocKey{index: req.Index, routing: req.Routing}
+	if action.Doc != nil {
+		key.id = action.Doc.ID()
+		if action.Doc.Index() != "" {
+			key.index = action.Doc.Index()
+		}
+	}
+
+	if action.Routing != "" {
+		key.routing = action.Routing
+	}
+
+	return key
+}
+
+// allPositions returns the positions of n actions
+func allPositions(n int) []int {
+	positions := make([]int, n)
+	for i := range positions {
+		positions[i] = i
+	}
+
+	return positions
+}
@@ -0,0 +1,238 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// flakyBulk responds to each action with the next status listed for its document ID,
+// and with a 201 once the statuses of the ID are exhausted.
+// If rejectRequests is positive, that many requests are first rejected as a whole with a 429.
+type flakyBulk struct {
+	statuses       map[string][]int
+	rejectRequests int
+	requests       []*BulkRequest
+}
+
+func (f *flakyBulk) Bulk(_ context.Context, req *BulkRequest) (OpenSearchResponse[BulkResponse], error) {
+	f.requests = append(f.requests, req)
+
+	if f.rejectRequests > 0 {
+		f.rejectRequests--
+		resp := BulkResponse{Error: &Error{Type: "rejected_execution_exception", Reason: "rejected"}}
+		return NewOpenSearchResponse(NewValidationResults(), http.StatusTooManyRequests, nil, resp), nil
+	}
+
+	resp := BulkResponse{Took: 1}
+	for _, a := range req.Actions {
+		item := ActionResponse{Type: string(a.Type), ID: a.Doc.ID(), Status: http.StatusCreated}
+		if statuses := f.statuses[a.Doc.ID()]; len(statuses) > 0 {
+			f.statuses[a.Doc.ID()] = statuses[1:]
+			if statuses[0] > 299 {
+				resp.Errors = true
+				item.Status = statuses[0]
+				item.Error = &ActionError{Type: fmt.Sprintf("status_%d", statuses[0])}
+			}
+		}
+
+		resp.Items = append(resp.Items, item)
+	}
+
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, resp), nil
+}
+
+func newTestBulkRetrier(t *testing.T, client Bulk, versionConflicts bool) *BulkRetrier {
+	retrier, err := NewBulkRetrier(BulkRetrierConfig{
+		Client:                client,
+		InitialBackoff:        time.Millisecond,
+		MaxBackoff:            time.Millisecond,
+		RetryVersionConflicts: versionConflicts,
+	})
+	require.Nil(t, err)
+
+	return retrier
+}
+
+func TestNewBulkRetrier_RequiresClient(t *testing.T) {
+	_, err := NewBulkRetrier(BulkRetrierConfig{})
+	require.NotNil(t, err)
+}
+
+func TestBulkRetrier_RetriesOnlyRetryableFailures(t *testing.T) {
+	client := &flakyBulk{statuses: map[string][]int{
+		"1": {http.StatusTooManyRequests},
+		"2": {http.StatusBadRequest},
+		"3": {http.StatusServiceUnavailable, http.StatusTooManyRequests},
+	}}
+
+	req := NewBulkRequest().WithIndex(testIndex1)
+	for i := 0; i < 5; i++ {
+		req.Add(NewIndexBulkAction(NewDocumentRef("", fmt.Sprint(i))))
+	}
+
+	resp, err := newTestBulkRetrier(t, client, false).Bulk(context.Background(), req)
+	require.Nil(t, err)
+
+	require.Len(t, client.requests, 3)
+	require.Equal(t, []string{"1", "3"}, actionIDs(client.requests[1]))
+	require.Equal(t, []string{"3"}, actionIDs(client.requests[2]))
+	require.Equal(t, testIndex1, client.requests[1].Index)
+
+	require.True(t, resp.Response.Errors)
+	require.Equal(t, int64(3), resp.Response.Took)
+	require.Len(t, resp.Response.Items, 5)
+	for i, item := range resp.Response.Items {
+		require.Equal(t, fmt.Sprint(i), item.ID)
+		if i == 2 {
+			require.Equal(t, http.StatusBadRequest, item.Status)
+		} else {
+			require.Equal(t, http.StatusCreated, item.Status)
+		}
+	}
+}
+
+func TestBulkRetrier_MaxRetries(t *testing.T) {
+	client := &flakyBulk{statuses: map[string][]int{
+		"1": {429, 429, 429, 429, 429},
+	}}
+
+	req := NewBulkRequest().Add(NewIndexBulkAction(NewDocumentRef(testIndex1, "1")))
+	resp, err := newTestBulkRetrier(t, client, false).Bulk(context.Background(), req)
+	require.Nil(t, err)
+
+	require.Len(t, client.requests, DefaultBulkRetrierMaxRetries+1)
+	require.True(t, resp.Response.Errors)
+	require.Equal(t, http.StatusTooManyRequests, resp.Response.Items[0].Status)
+}
+
+func TestBulkRetrier_LargeBackoff(t *testing.T) {
+	statuses := make([]int, 50)
+	for i := range statuses {
+		statuses[i] = http.StatusTooManyRequests
+	}
+
+	client := &flakyBulk{statuses: map[string][]int{"1": statuses}}
+	retrier, err := NewBulkRetrier(BulkRetrierConfig{
+		Client:         client,
+		MaxRetries:     40,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     time.Millisecond,
+	})
+	require.Nil(t, err)
+
+	req := NewBulkRequest().Add(NewIndexBulkAction(NewDocumentRef(testIndex1, "1")))
+	resp, err := retrier.Bulk(context.Background(), req)
+	require.Nil(t, err)
+	require.Len(t, client.requests, 41)
+	require.True(t, resp.Response.Errors)
+}
+
+func TestBulkRetrier_VersionConflicts(t *testing.T) {
+	req := NewBulkRequest().Add(NewIndexBulkAction(NewDocumentRef(testIndex1, "1")))
+
+	client := &flakyBulk{statuses: map[string][]int{"1": {http.StatusConflict}}}
+	resp, err := newTestBulkRetrier(t, client, false).Bulk(context.Background(), req)
+	require.Nil(t, err)
+	require.Len(t, client.requests, 1)
+	require.True(t, resp.Response.Errors)
+
+	client = &flakyBulk{statuses: map[string][]int{"1": {http.StatusConflict}}}
+	resp, err = newTestBulkRetrier(t, client, true).Bulk(context.Background(), req)
+	require.Nil(t, err)
+	require.Len(t, client.requests, 2)
+	require.False(t, resp.Response.Errors)
+}
+
+func TestBulkRetrier_PreservesDocumentOrder(t *testing.T) {
+	// the first action on document 1 is rejected while the later one succeeds,
+	// so both must be resent in order for the later one to remain the final state
+	client := &flakyBulk{statuses: map[string][]int{"1": {http.StatusTooManyRequests}}}
+
+	req := NewBulkRequest().Add(
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "1")),
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "2")),
+		NewDeleteBulkAction(testIndex1, "1"),
+		NewIndexBulkAction(NewDocumentRef("other_index", "1")),
+	)
+
+	resp, err := newTestBulkRetrier(t, client, false).Bulk(context.Background(), req)
+	require.Nil(t, err)
+	require.False(t, resp.Response.Errors)
+
+	require.Len(t, client.requests, 2)
+	retried := client.requests[1].Actions
+	require.Len(t, retried, 2)
+	require.Equal(t, BulkIndex, retried[0].Type)
+	require.Equal(t, BulkDelete, retried[1].Type)
+}
+
+func TestBulkRetrier_RetriesOnlyFailedActionsWithoutID(t *testing.T) {
+	// the ID-less actions all share the empty ID in flakyBulk, so only the second one is rejected
+	client := &flakyBulk{statuses: map[string][]int{"": {http.StatusCreated, http.StatusTooManyRequests}}}
+
+	req := NewBulkRequest().WithIndex(testIndex1)
+	for i := 0; i < 5; i++ {
+		req.Add(NewIndexBulkAction(NewDocumentRef("", "")).WithPipeline(fmt.Sprint(i)))
+	}
+
+	resp, err := newTestBulkRetrier(t, client, false).Bulk(context.Background(), req)
+	require.Nil(t, err)
+	require.False(t, resp.Response.Errors)
+
+	require.Len(t, client.requests, 2)
+	retried := client.requests[1].Actions
+	require.Len(t, retried, 1)
+	require.Equal(t, "1", retried[0].Pipeline)
+}
+
+func TestBulkRetrier_RejectedRequest(t *testing.T) {
+	client := &flakyBulk{rejectRequests: 2}
+
+	req := NewBulkRequest().Add(
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "1")),
+		NewIndexBulkAction(NewDocumentRef(testIndex1, "2")),
+	)
+
+	resp, err := newTestBulkRetrier(t, client, false).Bulk(context.Background(), req)
+	require.Nil(t, err)
+	require.Len(t, client.requests, 3)
+	require.False(t, resp.Response.Errors)
+	require.Nil(t, resp.Response.Error)
+	require.Len(t, resp.Response.Items, 2)
+
+	client = &flakyBulk{rejectRequests: 10}
+	resp, err = newTestBulkRetrier(t, client, false).Bulk(context.Background(), req)
+	require.Nil(t, err)
+	require.True(t, resp.Response.Errors)
+	require.Equal(t, http.StatusTooManyRequests, resp.Response.Items[1].Status)
+	require.Equal(t, "rejected_execution_exception", resp.Response.Items[1].Error.Type)
+}
+
+func TestBulkRetrier_ContextCanceled(t *testing.T) {
+	client := &flakyBulk{statuses: map[string][]int{"1": {http.StatusTooManyRequests}}}
+	retrier, err := NewBulkRetrier(BulkRetrierConfig{Client: client, InitialBackoff: time.Hour, MaxBackoff: time.Hour})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req := NewBulkRequest().Add(NewIndexBulkAction(NewDocumentRef(testIndex1, "1")))
+	resp, err := retrier.Bulk(ctx, req)
+	require.NotNil(t, err)
+	require.True(t, resp.Response.Errors)
+	require.Len(t, resp.Response.Items, 1)
+}
+
+func actionIDs(req *BulkRequest) []string {
+	ids := make([]string, len(req.Actions))
+	for i, a := range req.Actions {
+		ids[i] = a.Doc.ID()
+	}
+
+	return ids
+}
@@ -0,0 +1,315 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// DeleteByQuery defines a method which knows how to make an OpenSearch [Delete by query] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete by query]: https://opensearch.org/docs/latest/api-reference/document-apis/delete-by-query/
+type DeleteByQuery interface {
+	DeleteByQuery(ctx context.Context, req *DeleteByQueryRequest) (OpenSearchResponse[BulkByScrollResponse], error)
+}
+
+// UpdateByQuery defines a method which knows how to make an OpenSearch [Update by query] request.
+// It should be implemented by a version-specific executor.
+//
+// [Update by query]: https://opensearch.org/docs/latest/api-reference/document-apis/update-by-query/
+type UpdateByQuery interface {
+	UpdateByQuery(ctx context.Context, req *UpdateByQueryRequest) (OpenSearchResponse[BulkByScrollResponse], error)
+}
+
+// Conflicts determines what a by query or reindex operation does when it hits a version conflict
+type Conflicts string
+
+const (
+	// ConflictsAbort - default, the operation stops at the first version conflict
+	ConflictsAbort Conflicts = "abort"
+	// ConflictsProceed - version conflicts are counted and the operation continues
+	ConflictsProceed Conflicts = "proceed"
+)
+
+// SlicesAuto lets OpenSearch choose the number of slices of a by query or reindex operation, one per shard.
+const SlicesAuto = -1
+
+// DeleteByQueryRequest is a domain model union type for all the fields of a Delete by query request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DeleteByQueryRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteReq := NewDeleteByQueryRequest(NewRangeQuery("timestamp").Lt("now-30d"), "example_index").
+//		WithConflicts(ConflictsProceed).
+//		WithWaitForCompletion(false)
+//	deleteResp, err := osv2Executor.DeleteByQuery(ctx, deleteReq)
+//
+// When not waiting for completion, the response only contains the Task to be polled with a [GetTaskRequest].
+type DeleteByQueryRequest struct {
+	// Index(s) to delete documents from
+	Index []string
+
+	// Query matching the documents to delete
+	Query Query
+
+	// Routing - Value(s) used to route the operation to specific shards
+	Routing []string
+
+	// Conflicts determines what happens on a version conflict, ConflictsAbort if empty
+	Conflicts Conflicts
+
+	// Slices splits the operation into parallel sub-tasks, or SlicesAuto. Omitted if zero.
+	Slices int
+
+	// RequestsPerSecond throttles the operation, -1 disables throttling. Omitted if zero.
+	RequestsPerSecond int
+
+	// MaxDocs is the maximum number of documents to delete. Omitted if zero.
+	MaxDocs int
+
+	// Refresh the affected shards once the operation completes
+	Refresh bool
+
+	// WaitForCompletion - when false, the operation runs as a task whose ID is returned. Defaults to true if nil.
+	WaitForCompletion *bool
+}
+
+// NewDeleteByQueryRequest instantiates a DeleteByQueryRequest deleting the documents of the indices matching the query.
+func NewDeleteByQueryRequest(query Query, indices ...string) *DeleteByQueryRequest {
+	return &DeleteByQueryRequest{
+		Index: indices,
+		Query: query,
+	}
+}
+
+// WithRouting sets the routing value(s)
+func (r *DeleteByQueryRequest) WithRouting(routing ...string) *DeleteByQueryRequest {
+	r.Routing = routing
+	return r
+}
+
+// WithConflicts sets what happens on a version conflict
+func (r *DeleteByQueryRequest) WithConflicts(conflicts Conflicts) *DeleteByQueryRequest {
+	r.Conflicts = conflicts
+	return r
+}
+
+// WithSlices splits the operation into n parallel sub-tasks, or SlicesAuto
+func (r *DeleteByQueryRequest) WithSlices(n int) *DeleteByQueryRequest {
+	r.Slices = n
+	return r
+}
+
+// WithRequestsPerSecond throttles the operation, -1 disables throttling
+func (r *DeleteByQueryRequest) WithRequestsPerSecond(n int) *DeleteByQueryRequest {
+	r.RequestsPerSecond = n
+	return r
+}
+
+// WithMaxDocs sets the maximum number of documents to delete
+func (r *DeleteByQueryRequest) WithMaxDocs(n int) *DeleteByQueryRequest {
+	r.MaxDocs = n
+	return r
+}
+
+// WithRefresh sets whether the affected shards are refreshed once the operation completes
+func (r *DeleteByQueryRequest) WithRefresh(refresh bool) *DeleteByQueryRequest {
+	r.Refresh = refresh
+	return r
+}
+
+// WithWaitForCompletion sets whether the request waits for the operation to complete,
+// or returns the ID of its task right away
+func (r *DeleteByQueryRequest) WithWaitForCompletion(wait bool) *DeleteByQueryRequest {
+	r.WaitForCompletion = &wait
+	return r
+}
+
+// UpdateByQueryRequest is a domain model union type for all the fields of an Update by query request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This UpdateByQueryRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	updateReq := NewUpdateByQueryRequest(NewTermQuery("status", "stale"), "example_index").
+//		WithScript(NewScript("ctx._source.status = params.status").AddParam("status", "archived")).
+//		WithConflicts(ConflictsProceed)
+//	updateResp, err := osv2Executor.UpdateByQuery(ctx, updateReq)
+//
+// Without a Script, the matching documents are reindexed in place, picking up mapping changes.
+// When not waiting for completion, the response only contains the Task to be polled with a [GetTaskRequest].
+type UpdateByQueryRequest struct {
+	// Index(s) to update documents of
+	Index []string
+
+	// Query matching the documents to update, all documents if nil
+	Query Query
+
+	// Script to be executed against each document
+	Script *Script
+
+	// Routing - Value(s) used to route the operation to specific shards
+	Routing []string
+
+	// Conflicts determines what happens on a version conflict, ConflictsAbort if empty
+	Conflicts Conflicts
+
+	// Slices splits the operation into parallel sub-tasks, or SlicesAuto. Omitted if zero.
+	Slices int
+
+	// RequestsPerSecond throttles the operation, -1 disables throttling. Omitted if zero.
+	RequestsPerSecond int
+
+	// MaxDocs is the maximum number of documents to update. Omitted if zero.
+	MaxDocs int
+
+	// Refresh the affected shards once the operation completes
+	Refresh bool
+
+	// WaitForCompletion - when false, the operation runs as a task whose ID is returned. Defaults to true if nil.
+	WaitForCompletion *bool
+}
+
+// NewUpdateByQueryRequest instantiates an UpdateByQueryRequest updating the documents of the indices matching the query.
+func NewUpdateByQueryRequest(query Query, indices ...string) *UpdateByQueryRequest {
+	return &UpdateByQueryRequest{
+		Index: indices,
+		Query: query,
+	}
+}
+
+// WithScript sets the script to be executed against each document
+func (r *UpdateByQueryRequest) WithScript(script *Script) *UpdateByQueryRequest {
+	r.Script = script
+	return r
+}
+
+// WithRouting sets the routing value(s)
+func (r *UpdateByQueryRequest) WithRouting(routing ...string) *UpdateByQueryRequest {
+	r.Routing = routing
+	return r
+}
+
+// WithConflicts sets what happens on a version conflict
+func (r *UpdateByQueryRequest) WithConflicts(conflicts Conflicts) *UpdateByQueryRequest {
+	r.Conflicts = conflicts
+	return r
+}
+
+// WithSlices splits the operation into n parallel sub-tasks, or SlicesAuto
+func (r *UpdateByQueryRequest) WithSlices(n int) *UpdateByQueryRequest {
+	r.Slices = n
+	return r
+}
+
+// WithRequestsPerSecond throttles the operation, -1 disables throttling
+func (r *UpdateByQueryRequest) WithRequestsPerSecond(n int) *UpdateByQueryRequest {
+	r.RequestsPerSecond = n
+	return r
+}
+
+// WithMaxDocs sets the maximum number of documents to update
+func (r *UpdateByQueryRequest) WithMaxDocs(n int) *UpdateByQueryRequest {
+	r.MaxDocs = n
+	return r
+}
+
+// WithRefresh sets whether the affected shards are refreshed once the operation completes
+func (r *UpdateByQueryRequest) WithRefresh(refresh bool) *UpdateByQueryRequest {
+	r.Refresh = refresh
+	return r
+}
+
+// WithWaitForCompletion sets whether the request waits for the operation to complete,
+// or returns the ID of its task right away
+func (r *UpdateByQueryRequest) WithWaitForCompletion(wait bool) *UpdateByQueryRequest {
+	r.WaitForCompletion = &wait
+	return r
+}
+
+// BulkByScrollResponse is a domain model union response type for operations scrolling over documents and
+// writing changes in bulk, such as [DeleteByQueryRequest], [UpdateByQueryRequest] and [ReindexRequest],
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// If the operation was started without waiting for its completion, only Task is set.
+type BulkByScrollResponse struct {
+	// Task is the ID of the task running the operation, when not waiting for its completion
+	Task string
+
+	// Took is the time in milliseconds the operation took
+	Took int64
+
+	// TimedOut is true if a request of the operation timed out
+	TimedOut bool
+
+	// Total number of documents processed
+	Total int64
+
+	// Created number of documents
+	Created int64
+
+	// Deleted number of documents
+	Deleted int64
+
+	// Updated number of documents
+	Updated int64
+
+	// Batches is the number of scroll responses pulled back by the operation
+	Batches int64
+
+	// VersionConflicts is the number of version conflicts hit by the operation
+	VersionConflicts int64
+
+	// Noops is the number of documents ignored because a script set ctx.op to noop
+	Noops int64
+
+	// Retries attempted by the operation
+	Retries BulkByScrollRetries
+
+	// Failures of the operation, which stops on the first failed batch
+	Failures []BulkByScrollFailure
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
+
+// Failed reports whether the operation failed, either as a whole or on some documents.
+func (r BulkByScrollResponse) Failed() bool {
+	return r.Error != nil || len(r.Failures) > 0
+}
+
+// BulkByScrollRetries counts the retries attempted by a bulk by scroll operation.
+type BulkByScrollRetries struct {
+	// Bulk actions retried
+	Bulk int64
+
+	// Search actions retried
+	Search int64
+}
+
+// BulkByScrollFailure is a failure of a bulk by scroll operation, either on a document or on a shard.
+type BulkByScrollFailure struct {
+	// Index of the failure
+	Index string
+
+	// ID of the failed document, empty for a shard failure
+	ID string
+
+	// Shard that failed, -1 for a document failure
+	Shard int
+
+	// Node of the failed shard
+	Node string
+
+	// Status is the HTTP status code of a document failure
+	Status int
+
+	// Cause of the failure
+	Cause Error
+}
@@ -0,0 +1,79 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// Count defines a method which knows how to make an OpenSearch [Count] request.
+// It should be implemented by a version-specific executor.
+//
+// [Count]: https://opensearch.org/docs/latest/api-reference/count/
+type Count interface {
+	Count(ctx context.Context, req *CountRequest) (OpenSearchResponse[CountResponse], error)
+}
+
+// CountRequest is a domain model union type for all the fields of a Count request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// Counting is cheaper than a [SearchRequest] with a Size of 0 tracking total hits, as no hits are collected.
+// This CountRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	countReq := NewCountRequest().
+//		AddIndices("example_index").
+//		WithQuery(NewTermQuery("field", "value"))
+//	countResp, err := osv2Executor.Count(ctx, countReq)
+//
+// An error can be returned if
+//
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+type CountRequest struct {
+	// Query matching the documents to count, all documents if nil
+	Query Query
+
+	// Index(s) to be targeted by the count
+	Index []string
+
+	// Routing - Value(s) used to route the count operation to specific shards
+	Routing []string
+}
+
+// NewCountRequest instantiates an empty CountRequest, counting all documents of all indices.
+func NewCountRequest() *CountRequest {
+	return &CountRequest{}
+}
+
+// AddIndices adds indices to the index list of the request.
+func (r *CountRequest) AddIndices(indices ...string) *CountRequest {
+	r.Index = append(r.Index, indices...)
+	return r
+}
+
+// WithQuery matching the documents to be counted.
+func (r *CountRequest) WithQuery(q Query) *CountRequest {
+	r.Query = q
+	return r
+}
+
+// WithRouting sets the routing value(s)
+func (r *CountRequest) WithRouting(routing ...string) *CountRequest {
+	r.Routing = routing
+	return r
+}
+
+// CountResponse is a domain model union response type for Count for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type CountResponse struct {
+	// Count of the documents matching the query
+	Count int64
+
+	// Shards metadata about the shards counted
+	Shards ShardMeta
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
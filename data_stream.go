@@ -0,0 +1,199 @@
+package opensearchtools
+
+import (
+	"context"
+	"time"
+)
+
+// CreateDataStream defines a method which knows how to make an OpenSearch [Create Data Stream] request.
+// It should be implemented by a version-specific executor.
+//
+// [Create Data Stream]: https://opensearch.org/docs/latest/im-plugin/data-streams/#step-2-create-a-data-stream
+type CreateDataStream interface {
+	CreateDataStream(ctx context.Context, req *CreateDataStreamRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// DeleteDataStream defines a method which knows how to make an OpenSearch [Delete Data Stream] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete Data Stream]: https://opensearch.org/docs/latest/im-plugin/data-streams/#step-7-delete-a-data-stream
+type DeleteDataStream interface {
+	DeleteDataStream(ctx context.Context, req *DeleteDataStreamRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// GetDataStreams defines a method which knows how to make an OpenSearch [Get Data Streams] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get Data Streams]: https://opensearch.org/docs/latest/im-plugin/data-streams/#step-4-manage-data-streams
+type GetDataStreams interface {
+	GetDataStreams(ctx context.Context, req *GetDataStreamsRequest) (OpenSearchResponse[GetDataStreamsResponse], error)
+}
+
+// DataStreamStats defines a method which knows how to make an OpenSearch [Data Stream Stats] request.
+// It should be implemented by a version-specific executor.
+//
+// [Data Stream Stats]: https://opensearch.org/docs/latest/im-plugin/data-streams/#step-4-manage-data-streams
+type DataStreamStats interface {
+	DataStreamStats(ctx context.Context, req *DataStreamStatsRequest) (OpenSearchResponse[DataStreamStatsResponse], error)
+}
+
+// CreateDataStreamRequest is a domain model union type for all the fields of a Create Data Stream request
+// for all supported OpenSearch versions.
+// The name of the data stream must match an [IndexTemplate] with data streams enabled.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This CreateDataStreamRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	createReq := NewCreateDataStreamRequest("logs-nginx")
+//	createResp, err := osv2Executor.CreateDataStream(ctx, createReq)
+type CreateDataStreamRequest struct {
+	// Name of the data stream to create
+	Name string
+}
+
+// NewCreateDataStreamRequest instantiates a CreateDataStreamRequest for the data stream with the given name.
+func NewCreateDataStreamRequest(name string) *CreateDataStreamRequest {
+	return &CreateDataStreamRequest{
+		Name: name,
+	}
+}
+
+// DeleteDataStreamRequest is a domain model union type for all the fields of a Delete Data Stream request
+// for all supported OpenSearch versions. The backing indices of the data streams are deleted.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DeleteDataStreamRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteReq := NewDeleteDataStreamRequest("logs-nginx")
+//	deleteResp, err := osv2Executor.DeleteDataStream(ctx, deleteReq)
+type DeleteDataStreamRequest struct {
+	// Names of the data streams to delete, wildcards are supported
+	Names []string
+}
+
+// NewDeleteDataStreamRequest instantiates a DeleteDataStreamRequest for the data streams with the given names.
+func NewDeleteDataStreamRequest(names ...string) *DeleteDataStreamRequest {
+	return &DeleteDataStreamRequest{
+		Names: names,
+	}
+}
+
+// GetDataStreamsRequest is a domain model union type for all the fields of a Get Data Streams request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetDataStreamsRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetDataStreamsRequest("logs-*")
+//	getResp, err := osv2Executor.GetDataStreams(ctx, getReq)
+type GetDataStreamsRequest struct {
+	// Names of the data streams to get, wildcards are supported. All data streams if empty.
+	Names []string
+}
+
+// NewGetDataStreamsRequest instantiates a GetDataStreamsRequest for the data streams with the given names.
+func NewGetDataStreamsRequest(names ...string) *GetDataStreamsRequest {
+	return &GetDataStreamsRequest{
+		Names: names,
+	}
+}
+
+// GetDataStreamsResponse is a domain model union response type for GetDataStreamsRequest
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetDataStreamsResponse struct {
+	// DataStreams found
+	DataStreams []DataStream
+
+	// Error of the request, such as when a data stream doesn't exist
+	Error *Error
+}
+
+// DataStream is a data stream in a [GetDataStreamsResponse].
+type DataStream struct {
+	// Name of the data stream
+	Name string
+
+	// TimestampField of the documents of the data stream
+	TimestampField string
+
+	// Indices backing the data stream, the last one being the write index
+	Indices []string
+
+	// Generation of the data stream, incremented on every rollover
+	Generation int
+
+	// Status of the health of the data stream, such as GREEN
+	Status string
+
+	// Template is the name of the index template of the data stream
+	Template string
+}
+
+// DataStreamStatsRequest is a domain model union type for all the fields of a Data Stream Stats request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DataStreamStatsRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	statsReq := NewDataStreamStatsRequest("logs-*")
+//	statsResp, err := osv2Executor.DataStreamStats(ctx, statsReq)
+type DataStreamStatsRequest struct {
+	// Names of the data streams to get the stats of, wildcards are supported. All data streams if empty.
+	Names []string
+}
+
+// NewDataStreamStatsRequest instantiates a DataStreamStatsRequest for the data streams with the given names.
+func NewDataStreamStatsRequest(names ...string) *DataStreamStatsRequest {
+	return &DataStreamStatsRequest{
+		Names: names,
+	}
+}
+
+// DataStreamStatsResponse is a domain model union response type for DataStreamStatsRequest
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type DataStreamStatsResponse struct {
+	// Shards the stats were collected from
+	Shards ShardMeta
+
+	// DataStreamCount is the number of data streams
+	DataStreamCount int
+
+	// BackingIndices is the number of indices backing the data streams
+	BackingIndices int
+
+	// TotalStoreSizeBytes of the backing indices
+	TotalStoreSizeBytes int64
+
+	// DataStreams are the stats of each data stream
+	DataStreams []DataStreamStat
+
+	// Error of the request
+	Error *Error
+}
+
+// DataStreamStat are the stats of a data stream in a [DataStreamStatsResponse].
+type DataStreamStat struct {
+	// Name of the data stream
+	Name string
+
+	// BackingIndices is the number of indices backing the data stream
+	BackingIndices int
+
+	// StoreSizeBytes of the backing indices
+	StoreSizeBytes int64
+
+	// MaximumTimestamp of the documents of the data stream
+	MaximumTimestamp time.Time
+}
@@ -0,0 +1,73 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// Delete defines a method which knows how to make an OpenSearch [Delete document] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete document]: https://opensearch.org/docs/latest/api-reference/document-apis/delete-document/
+type Delete interface {
+	Delete(ctx context.Context, req *DeleteRequest) (OpenSearchResponse[DeleteResponse], error)
+}
+
+// DeleteRequest is a domain model union type for all the fields of a Delete document request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DeleteRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteReq := NewDeleteRequest("example_index", "example_id")
+//	deleteResp, err := osv2Executor.Delete(ctx, deleteReq)
+//
+// If the document doesn't exist, OpenSearch doesn't return an error, but instead returns not_found under DeleteResponse.Result.
+type DeleteRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to delete
+	ID string
+
+	// Refresh determines if the request should wait for a refresh or not
+	Refresh Refresh
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+}
+
+// NewDeleteRequest instantiates a DeleteRequest for the document with the provided index and id.
+func NewDeleteRequest(index, id string) *DeleteRequest {
+	return &DeleteRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// WithRefresh sets the refresh policy of the request
+func (r *DeleteRequest) WithRefresh(refresh Refresh) *DeleteRequest {
+	r.Refresh = refresh
+	return r
+}
+
+// WithRouting sets the routing value
+func (r *DeleteRequest) WithRouting(routing string) *DeleteRequest {
+	r.Routing = routing
+	return r
+}
+
+// DeleteResponse is a domain model union response type for DeleteRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type DeleteResponse struct {
+	Index       string
+	ID          string
+	Version     uint64
+	Result      string
+	Shards      ShardMeta
+	SeqNo       uint64
+	PrimaryTerm uint64
+	Error       *Error
+}
@@ -0,0 +1,56 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// Exists defines a method which knows how to make an OpenSearch document [Exists] request.
+// It should be implemented by a version-specific executor.
+//
+// [Exists]: https://opensearch.org/docs/latest/api-reference/document-apis/get-documents/
+type Exists interface {
+	Exists(ctx context.Context, req *ExistsRequest) (OpenSearchResponse[ExistsResponse], error)
+}
+
+// ExistsRequest is a domain model union type for all the fields of a document Exists request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This ExistsRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	existsReq := NewExistsRequest("example_index", "example_id")
+//	existsResp, err := osv2Executor.Exists(ctx, existsReq)
+type ExistsRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to check
+	ID string
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+}
+
+// NewExistsRequest instantiates an ExistsRequest for the document with the provided index and id.
+func NewExistsRequest(index, id string) *ExistsRequest {
+	return &ExistsRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// WithRouting sets the routing value
+func (r *ExistsRequest) WithRouting(routing string) *ExistsRequest {
+	r.Routing = routing
+	return r
+}
+
+// ExistsResponse is a domain model union response type for ExistsRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type ExistsResponse struct {
+	// Exists is true if the document was found
+	Exists bool
+}
@@ -0,0 +1,68 @@
+package opensearchtools
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Get defines a method which knows how to make an OpenSearch [Get document] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get document]: https://opensearch.org/docs/latest/api-reference/document-apis/get-documents/
+type Get interface {
+	Get(ctx context.Context, req *GetRequest) (OpenSearchResponse[GetResponse], error)
+}
+
+// GetRequest is a domain model union type for all the fields of a Get document request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetRequest("example_index", "example_id")
+//	getResp, err := osv2Executor.Get(ctx, getReq)
+type GetRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to fetch
+	ID string
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+}
+
+// NewGetRequest instantiates a GetRequest for the document with the provided index and id.
+func NewGetRequest(index, id string) *GetRequest {
+	return &GetRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// WithRouting sets the routing value
+func (r *GetRequest) WithRouting(routing string) *GetRequest {
+	r.Routing = routing
+	return r
+}
+
+// GetResponse is a domain model union response type for GetRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetResponse struct {
+	Index       string
+	ID          string
+	Version     uint64
+	SeqNo       uint64
+	PrimaryTerm uint64
+	Found       bool
+	Source      json.RawMessage
+	Error       *Error
+}
+
+// GetSource returns the raw bytes of the document of the [GetResponse].
+func (r GetResponse) GetSource() []byte {
+	return []byte(r.Source)
+}
@@ -0,0 +1,68 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// Index defines a method which knows how to make an OpenSearch [Index document] request.
+// It should be implemented by a version-specific executor.
+//
+// [Index document]: https://opensearch.org/docs/latest/api-reference/document-apis/index-document/
+type Index interface {
+	Index(ctx context.Context, req *IndexRequest) (OpenSearchResponse[IndexResponse], error)
+}
+
+// IndexRequest is a domain model union type for all the fields of an Index document request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This IndexRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	indexReq := NewIndexRequest(doc).
+//		WithRefresh(WaitFor)
+//	indexResp, err := osv2Executor.Index(ctx, indexReq)
+//
+// If [RoutableDoc.ID] is empty, OpenSearch will generate an ID for the document.
+type IndexRequest struct {
+	// Doc to be indexed. The Doc is marshaled as the document source.
+	Doc RoutableDoc
+
+	// Refresh determines if the request should wait for a refresh or not
+	Refresh Refresh
+
+	// Routing value used to route the document to a specific shard
+	Routing string
+}
+
+// NewIndexRequest instantiates an IndexRequest for the provided doc.
+func NewIndexRequest(doc RoutableDoc) *IndexRequest {
+	return &IndexRequest{Doc: doc}
+}
+
+// WithRefresh sets the refresh policy of the request
+func (r *IndexRequest) WithRefresh(refresh Refresh) *IndexRequest {
+	r.Refresh = refresh
+	return r
+}
+
+// WithRouting sets the routing value
+func (r *IndexRequest) WithRouting(routing string) *IndexRequest {
+	r.Routing = routing
+	return r
+}
+
+// IndexResponse is a domain model union response type for IndexRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type IndexResponse struct {
+	Index       string
+	ID          string
+	Version     uint64
+	Result      string
+	Shards      ShardMeta
+	SeqNo       uint64
+	PrimaryTerm uint64
+	Error       *Error
+}
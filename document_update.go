@@ -0,0 +1,241 @@
+package opensearchtools
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Update defines a method which knows how to make an OpenSearch [Update document] request.
+// It should be implemented by a version-specific executor.
+//
+// [Update document]: https://opensearch.org/docs/latest/api-reference/document-apis/update-document/
+type Update interface {
+	Update(ctx context.Context, req *UpdateRequest) (OpenSearchResponse[UpdateResponse], error)
+}
+
+// UpdateBody is the body of an update to a single document, shared by [UpdateRequest] and
+// [BulkAction]s created with [NewUpdateRequestBulkAction].
+// Either a partial Doc or a Script must be set, but not both.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/update-document/#request-body
+type UpdateBody struct {
+	// Doc is a partial document to be merged into the existing document
+	Doc any
+
+	// DocAsUpsert uses the partial Doc as the upsert document if the document doesn't exist
+	DocAsUpsert bool
+
+	// Upsert is the document to be indexed if the document doesn't exist
+	Upsert any
+
+	// ScriptedUpsert runs the Script whether or not the document exists
+	ScriptedUpsert bool
+
+	// Script to be executed against the document
+	Script *Script
+
+	// FetchSource returns the updated document source in the response
+	FetchSource bool
+}
+
+// Validate that the update body is executable.
+func (b *UpdateBody) Validate() ValidationResults {
+	vrs := NewValidationResults()
+
+	if b.Doc == nil && b.Script == nil {
+		vrs.Add(NewValidationResult("an update requires either a Doc or a Script", true))
+	}
+
+	if b.Doc != nil && b.Script != nil {
+		vrs.Add(NewValidationResult("an update cannot have both a Doc and a Script", true))
+	}
+
+	if b.DocAsUpsert && b.Doc == nil {
+		vrs.Add(NewValidationResult("DocAsUpsert requires a Doc", true))
+	}
+
+	if b.DocAsUpsert && b.Upsert != nil {
+		vrs.Add(NewValidationResult("an update cannot have both DocAsUpsert and an Upsert document", true))
+	}
+
+	if b.ScriptedUpsert && b.Script == nil {
+		vrs.Add(NewValidationResult("ScriptedUpsert requires a Script", true))
+	}
+
+	if b.Script != nil {
+		vrs.Extend(b.Script.Validate())
+	}
+
+	return vrs
+}
+
+// ToOpenSearchJSON converts the UpdateBody to the correct OpenSearch JSON.
+func (b *UpdateBody) ToOpenSearchJSON() ([]byte, error) {
+	if vrs := b.Validate(); vrs.IsFatal() {
+		return nil, NewValidationError(vrs)
+	}
+
+	source := make(map[string]any)
+	if b.Doc != nil {
+		source["doc"] = b.Doc
+	}
+
+	if b.DocAsUpsert {
+		source["doc_as_upsert"] = true
+	}
+
+	if b.Upsert != nil {
+		source["upsert"] = b.Upsert
+	}
+
+	if b.ScriptedUpsert {
+		source["scripted_upsert"] = true
+	}
+
+	if b.Script != nil {
+		scriptJSON, jErr := b.Script.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		source["script"] = json.RawMessage(scriptJSON)
+	}
+
+	if b.FetchSource {
+		source["_source"] = true
+	}
+
+	return json.Marshal(source)
+}
+
+// UpdateRequest is a domain model union type for all the fields of an Update document request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This UpdateRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	updateReq := NewUpdateRequest("example_index", "example_id").
+//		WithScript(NewScript("ctx._source.count += params.inc").AddParam("inc", 1)).
+//		WithUpsert(map[string]any{"count": 1})
+//	updateResp, err := osv2Executor.Update(ctx, updateReq)
+//
+// Optimistic concurrency control is only applied when IfPrimaryTerm is greater than zero,
+// as OpenSearch primary terms start at 1.
+type UpdateRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to update
+	ID string
+
+	// Body of the update
+	Body UpdateBody
+
+	// Refresh determines if the request should wait for a refresh or not
+	Refresh Refresh
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+
+	// RetryOnConflict is the number of times to retry the update if a version conflict occurs
+	RetryOnConflict int
+
+	// IfSeqNo only performs the update if the document has this sequence number
+	IfSeqNo uint64
+
+	// IfPrimaryTerm only performs the update if the document has this primary term
+	IfPrimaryTerm uint64
+}
+
+// NewUpdateRequest instantiates an UpdateRequest for the document with the provided index and id.
+func NewUpdateRequest(index, id string) *UpdateRequest {
+	return &UpdateRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// WithDoc sets the partial document to be merged into the existing document
+func (r *UpdateRequest) WithDoc(doc any) *UpdateRequest {
+	r.Body.Doc = doc
+	return r
+}
+
+// WithDocAsUpsert sets whether the partial document is used as the upsert document
+func (r *UpdateRequest) WithDocAsUpsert(docAsUpsert bool) *UpdateRequest {
+	r.Body.DocAsUpsert = docAsUpsert
+	return r
+}
+
+// WithUpsert sets the document to be indexed if the document doesn't exist
+func (r *UpdateRequest) WithUpsert(upsert any) *UpdateRequest {
+	r.Body.Upsert = upsert
+	return r
+}
+
+// WithScript sets the script to be executed against the document
+func (r *UpdateRequest) WithScript(script *Script) *UpdateRequest {
+	r.Body.Script = script
+	return r
+}
+
+// WithScriptedUpsert sets whether the script runs whether or not the document exists
+func (r *UpdateRequest) WithScriptedUpsert(scriptedUpsert bool) *UpdateRequest {
+	r.Body.ScriptedUpsert = scriptedUpsert
+	return r
+}
+
+// WithFetchSource sets whether the updated document source is returned
+func (r *UpdateRequest) WithFetchSource(fetchSource bool) *UpdateRequest {
+	r.Body.FetchSource = fetchSource
+	return r
+}
+
+// WithRefresh sets the refresh policy of the request
+func (r *UpdateRequest) WithRefresh(refresh Refresh) *UpdateRequest {
+	r.Refresh = refresh
+	return r
+}
+
+// WithRouting sets the routing value
+func (r *UpdateRequest) WithRouting(routing string) *UpdateRequest {
+	r.Routing = routing
+	return r
+}
+
+// WithRetryOnConflict sets the number of times to retry the update on a version conflict
+func (r *UpdateRequest) WithRetryOnConflict(retries int) *UpdateRequest {
+	r.RetryOnConflict = retries
+	return r
+}
+
+// WithIfSeqNoPrimaryTerm only performs the update if the document has the provided sequence number and primary term
+func (r *UpdateRequest) WithIfSeqNoPrimaryTerm(seqNo, primaryTerm uint64) *UpdateRequest {
+	r.IfSeqNo = seqNo
+	r.IfPrimaryTerm = primaryTerm
+	return r
+}
+
+// UpdateResponse is a domain model union response type for UpdateRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// Source is only populated if [UpdateBody.FetchSource] was requested.
+type UpdateResponse struct {
+	Index       string
+	ID          string
+	Version     uint64
+	Result      string
+	Shards      ShardMeta
+	SeqNo       uint64
+	PrimaryTerm uint64
+	Source      json.RawMessage
+	Error       *Error
+}
+
+// GetSource returns the raw bytes of the updated document of the [UpdateResponse].
+func (r UpdateResponse) GetSource() []byte {
+	return []byte(r.Source)
+}
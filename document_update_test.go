@@ -0,0 +1,77 @@
+package opensearchtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateBody_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  *UpdateBody
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Empty body",
+			target:  &UpdateBody{},
+			wantErr: true,
+		},
+		{
+			name:    "Partial doc",
+			target:  &UpdateBody{Doc: map[string]any{"field": "value"}},
+			want:    `{"doc":{"field":"value"}}`,
+			wantErr: false,
+		},
+		{
+			name:    "Partial doc as upsert",
+			target:  &UpdateBody{Doc: map[string]any{"field": "value"}, DocAsUpsert: true, FetchSource: true},
+			want:    `{"doc":{"field":"value"},"doc_as_upsert":true,"_source":true}`,
+			wantErr: false,
+		},
+		{
+			name: "Scripted upsert",
+			target: &UpdateBody{
+				Script:         NewScript("ctx._source.count++"),
+				ScriptedUpsert: true,
+				Upsert:         map[string]any{},
+			},
+			want:    `{"script":{"source":"ctx._source.count++"},"scripted_upsert":true,"upsert":{}}`,
+			wantErr: false,
+		},
+		{
+			name:    "Doc and Script fails",
+			target:  &UpdateBody{Doc: map[string]any{}, Script: NewScript("ctx._source.count++")},
+			wantErr: true,
+		},
+		{
+			name:    "DocAsUpsert with Upsert fails",
+			target:  &UpdateBody{Doc: map[string]any{}, DocAsUpsert: true, Upsert: map[string]any{}},
+			wantErr: true,
+		},
+		{
+			name:    "ScriptedUpsert without a Script fails",
+			target:  &UpdateBody{Doc: map[string]any{}, ScriptedUpsert: true},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Script fails",
+			target:  &UpdateBody{Script: &Script{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.target.ToOpenSearchJSON()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToOpenSearchJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err == nil {
+				require.JSONEq(t, tt.want, string(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// CreateIndex defines a method which knows how to make an OpenSearch [Create Index] request.
+// It should be implemented by a version-specific executor.
+//
+// [Create Index]: https://opensearch.org/docs/latest/api-reference/index-apis/create-index/
+type CreateIndex interface {
+	CreateIndex(ctx context.Context, req *CreateIndexRequest) (OpenSearchResponse[CreateIndexResponse], error)
+}
+
+// DeleteIndex defines a method which knows how to make an OpenSearch [Delete Index] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete Index]: https://opensearch.org/docs/latest/api-reference/index-apis/delete-index/
+type DeleteIndex interface {
+	DeleteIndex(ctx context.Context, req *DeleteIndexRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// IndexExists defines a method which knows how to make an OpenSearch [Index Exists] request.
+// It should be implemented by a version-specific executor.
+//
+// [Index Exists]: https://opensearch.org/docs/latest/api-reference/index-apis/exists/
+type IndexExists interface {
+	IndexExists(ctx context.Context, req *IndexExistsRequest) (OpenSearchResponse[IndexExistsResponse], error)
+}
+
+// OpenIndex defines a method which knows how to make an OpenSearch [Open Index] request.
+// It should be implemented by a version-specific executor.
+//
+// [Open Index]: https://opensearch.org/docs/latest/api-reference/index-apis/open-index/
+type OpenIndex interface {
+	OpenIndex(ctx context.Context, req *OpenIndexRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// CloseIndex defines a method which knows how to make an OpenSearch [Close Index] request.
+// It should be implemented by a version-specific executor.
+//
+// [Close Index]: https://opensearch.org/docs/latest/api-reference/index-apis/close-index/
+type CloseIndex interface {
+	CloseIndex(ctx context.Context, req *CloseIndexRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// CreateIndexRequest is a domain model union type for all the fields of a Create Index request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This CreateIndexRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	createReq := NewCreateIndexRequest("example_index").
+//		WithSettings(NewIndexSettings().WithNumberOfShards(1)).
+//		AddAliases(NewAlias("example_alias"))
+//	createResp, err := osv2Executor.CreateIndex(ctx, createReq)
+type CreateIndexRequest struct {
+	// Index to create
+	Index string
+
+	// Settings of the index
+	Settings *IndexSettings
+
+	// Mapping of the index
+	Mapping *Mapping
+
+	// RawSettings of the index in the JSON shape expected by OpenSearch, for settings which [IndexSettings]
+	// doesn't model. It cannot be set along with Settings.
+	RawSettings map[string]any
+
+	// Mappings of the index in the JSON shape expected by OpenSearch. It cannot be set along with Mapping.
+	Mappings map[string]any
+
+	// Aliases of the index
+	Aliases []*Alias
+}
+
+// NewCreateIndexRequest instantiates a CreateIndexRequest for the given index.
+func NewCreateIndexRequest(index string) *CreateIndexRequest {
+	return &CreateIndexRequest{
+		Index: index,
+	}
+}
+
+// WithSettings sets the settings of the index
+func (r *CreateIndexRequest) WithSettings(settings *IndexSettings) *CreateIndexRequest {
+	r.Settings = settings
+	return r
+}
+
+// WithMapping sets the mapping of the index
+func (r *CreateIndexRequest) WithMapping(mapping *Mapping) *CreateIndexRequest {
+	r.Mapping = mapping
+	return r
+}
+
+// WithRawSettings sets the settings of the index in the JSON shape expected by OpenSearch
+func (r *CreateIndexRequest) WithRawSettings(settings map[string]any) *CreateIndexRequest {
+	r.RawSettings = settings
+	return r
+}
+
+// WithMappings sets the mappings of the index in the JSON shape expected by OpenSearch
+func (r *CreateIndexRequest) WithMappings(mappings map[string]any) *CreateIndexRequest {
+	r.Mappings = mappings
+	return r
+}
+
+// AddAliases adds aliases to the index
+func (r *CreateIndexRequest) AddAliases(aliases ...*Alias) *CreateIndexRequest {
+	r.Aliases = append(r.Aliases, aliases...)
+	return r
+}
+
+// CreateIndexResponse is a domain model union response type for CreateIndexRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type CreateIndexResponse struct {
+	// Acknowledged is true if the index was created
+	Acknowledged bool
+
+	// ShardsAcknowledged is true if the shards of the index were started before the request timed out
+	ShardsAcknowledged bool
+
+	// Index that was created
+	Index string
+
+	// Error of the request, such as when the index already exists
+	Error *Error
+}
+
+// DeleteIndexRequest is a domain model union type for all the fields of a Delete Index request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DeleteIndexRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteReq := NewDeleteIndexRequest("example_index").WithIgnoreUnavailable(true)
+//	deleteResp, err := osv2Executor.DeleteIndex(ctx, deleteReq)
+type DeleteIndexRequest struct {
+	// Indices to delete, wildcards are supported
+	Indices []string
+
+	// IgnoreUnavailable ignores missing or closed indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, the request fails if a wildcard resolves to no index. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// NewDeleteIndexRequest instantiates a DeleteIndexRequest for the given indices.
+func NewDeleteIndexRequest(indices ...string) *DeleteIndexRequest {
+	return &DeleteIndexRequest{
+		Indices: indices,
+	}
+}
+
+// WithIgnoreUnavailable sets whether missing or closed indices are ignored
+func (r *DeleteIndexRequest) WithIgnoreUnavailable(ignoreUnavailable bool) *DeleteIndexRequest {
+	r.IgnoreUnavailable = ignoreUnavailable
+	return r
+}
+
+// WithAllowNoIndices sets whether a wildcard may resolve to no index
+func (r *DeleteIndexRequest) WithAllowNoIndices(allowNoIndices bool) *DeleteIndexRequest {
+	r.AllowNoIndices = &allowNoIndices
+	return r
+}
+
+// IndexExistsRequest is a domain model union type for all the fields of an Index Exists request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This IndexExistsRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	existsReq := NewIndexExistsRequest("example_index")
+//	existsResp, err := osv2Executor.IndexExists(ctx, existsReq)
+type IndexExistsRequest struct {
+	// Indices to check, all of them must exist. Wildcards are supported.
+	Indices []string
+
+	// IgnoreUnavailable ignores missing or closed indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, a wildcard resolving to no index doesn't exist. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// NewIndexExistsRequest instantiates an IndexExistsRequest for the given indices.
+func NewIndexExistsRequest(indices ...string) *IndexExistsRequest {
+	return &IndexExistsRequest{
+		Indices: indices,
+	}
+}
+
+// WithIgnoreUnavailable sets whether missing or closed indices are ignored
+func (r *IndexExistsRequest) WithIgnoreUnavailable(ignoreUnavailable bool) *IndexExistsRequest {
+	r.IgnoreUnavailable = ignoreUnavailable
+	return r
+}
+
+// WithAllowNoIndices sets whether a wildcard may resolve to no index
+func (r *IndexExistsRequest) WithAllowNoIndices(allowNoIndices bool) *IndexExistsRequest {
+	r.AllowNoIndices = &allowNoIndices
+	return r
+}
+
+// IndexExistsResponse is a domain model union response type for IndexExistsRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type IndexExistsResponse struct {
+	// Exists is true if the indices were found
+	Exists bool
+}
+
+// OpenIndexRequest is a domain model union type for all the fields of an Open Index request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This OpenIndexRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	openReq := NewOpenIndexRequest("example_index")
+//	openResp, err := osv2Executor.OpenIndex(ctx, openReq)
+type OpenIndexRequest struct {
+	// Indices to open, wildcards are supported
+	Indices []string
+
+	// IgnoreUnavailable ignores missing indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, the request fails if a wildcard resolves to no index. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// NewOpenIndexRequest instantiates an OpenIndexRequest for the given indices.
+func NewOpenIndexRequest(indices ...string) *OpenIndexRequest {
+	return &OpenIndexRequest{
+		Indices: indices,
+	}
+}
+
+// WithIgnoreUnavailable sets whether missing indices are ignored
+func (r *OpenIndexRequest) WithIgnoreUnavailable(ignoreUnavailable bool) *OpenIndexRequest {
+	r.IgnoreUnavailable = ignoreUnavailable
+	return r
+}
+
+// WithAllowNoIndices sets whether a wildcard may resolve to no index
+func (r *OpenIndexRequest) WithAllowNoIndices(allowNoIndices bool) *OpenIndexRequest {
+	r.AllowNoIndices = &allowNoIndices
+	return r
+}
+
+// CloseIndexRequest is a domain model union type for all the fields of a Close Index request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This CloseIndexRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	closeReq := NewCloseIndexRequest("example_index")
+//	closeResp, err := osv2Executor.CloseIndex(ctx, closeReq)
+type CloseIndexRequest struct {
+	// Indices to close, wildcards are supported
+	Indices []string
+
+	// IgnoreUnavailable ignores missing indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, the request fails if a wildcard resolves to no index. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// NewCloseIndexRequest instantiates a CloseIndexRequest for the given indices.
+func NewCloseIndexRequest(indices ...string) *CloseIndexRequest {
+	return &CloseIndexRequest{
+		Indices: indices,
+	}
+}
+
+// WithIgnoreUnavailable sets whether missing indices are ignored
+func (r *CloseIndexRequest) WithIgnoreUnavailable(ignoreUnavailable bool) *CloseIndexRequest {
+	r.IgnoreUnavailable = ignoreUnavailable
+	return r
+}
+
+// WithAllowNoIndices sets whether a wildcard may resolve to no index
+func (r *CloseIndexRequest) WithAllowNoIndices(allowNoIndices bool) *CloseIndexRequest {
+	r.AllowNoIndices = &allowNoIndices
+	return r
+}
+
+// AcknowledgedResponse is a domain model union response type for the index APIs which only acknowledge
+// the request, for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type AcknowledgedResponse struct {
+	// Acknowledged is true if the cluster applied the change
+	Acknowledged bool
+
+	// ShardsAcknowledged is true if the affected shards were started before the request timed out.
+	// It's only reported by the APIs opening indices.
+	ShardsAcknowledged bool
+
+	// Error of the request, such as when an index doesn't exist
+	Error *Error
+}
@@ -0,0 +1,660 @@
+package opensearchtools
+
+import (
+	"context"
+	"time"
+)
+
+// PutISMPolicy defines a method which knows how to make an OpenSearch [Put ISM Policy] request.
+// It should be implemented by a version-specific executor.
+//
+// [Put ISM Policy]: https://opensearch.org/docs/latest/im-plugin/ism/api/#create-policy
+type PutISMPolicy interface {
+	PutISMPolicy(ctx context.Context, req *PutISMPolicyRequest) (OpenSearchResponse[ISMPolicyResponse], error)
+}
+
+// GetISMPolicy defines a method which knows how to make an OpenSearch [Get ISM Policy] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get ISM Policy]: https://opensearch.org/docs/latest/im-plugin/ism/api/#get-policy
+type GetISMPolicy interface {
+	GetISMPolicy(ctx context.Context, req *GetISMPolicyRequest) (OpenSearchResponse[ISMPolicyResponse], error)
+}
+
+// DeleteISMPolicy defines a method which knows how to make an OpenSearch [Delete ISM Policy] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete ISM Policy]: https://opensearch.org/docs/latest/im-plugin/ism/api/#delete-policy
+type DeleteISMPolicy interface {
+	DeleteISMPolicy(ctx context.Context, req *DeleteISMPolicyRequest) (OpenSearchResponse[DeleteISMPolicyResponse], error)
+}
+
+// AddISMPolicy defines a method which knows how to make an OpenSearch [Add ISM Policy] request.
+// It should be implemented by a version-specific executor.
+//
+// [Add ISM Policy]: https://opensearch.org/docs/latest/im-plugin/ism/api/#add-policy
+type AddISMPolicy interface {
+	AddISMPolicy(ctx context.Context, req *AddISMPolicyRequest) (OpenSearchResponse[ISMIndicesResponse], error)
+}
+
+// RemoveISMPolicy defines a method which knows how to make an OpenSearch [Remove ISM Policy] request.
+// It should be implemented by a version-specific executor.
+//
+// [Remove ISM Policy]: https://opensearch.org/docs/latest/im-plugin/ism/api/#remove-policy
+type RemoveISMPolicy interface {
+	RemoveISMPolicy(ctx context.Context, req *RemoveISMPolicyRequest) (OpenSearchResponse[ISMIndicesResponse], error)
+}
+
+// ChangeISMPolicy defines a method which knows how to make an OpenSearch [Change ISM Policy] request.
+// It should be implemented by a version-specific executor.
+//
+// [Change ISM Policy]: https://opensearch.org/docs/latest/im-plugin/ism/api/#update-managed-index-policy
+type ChangeISMPolicy interface {
+	ChangeISMPolicy(ctx context.Context, req *ChangeISMPolicyRequest) (OpenSearchResponse[ISMIndicesResponse], error)
+}
+
+// ExplainISM defines a method which knows how to make an OpenSearch [Explain ISM] request.
+// It should be implemented by a version-specific executor.
+//
+// [Explain ISM]: https://opensearch.org/docs/latest/im-plugin/ism/api/#explain-index
+type ExplainISM interface {
+	ExplainISM(ctx context.Context, req *ExplainISMRequest) (OpenSearchResponse[ExplainISMResponse], error)
+}
+
+// ISMPolicy is a domain model union type for an Index State Management policy for all supported OpenSearch versions.
+// An index managed by a policy starts in the default state, runs the actions of its current state in order,
+// then moves to the state of the first transition whose conditions are met.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// For example:
+//
+//	policy := NewISMPolicy("logs", "hot").
+//		AddStates(
+//			NewISMState("hot").
+//				AddActions(NewISMRolloverAction().WithMinSize("50gb").WithMinIndexAge(24 * time.Hour)).
+//				AddTransitions(NewISMTransition("delete").WithMinIndexAge(30 * 24 * time.Hour)),
+//			NewISMState("delete").AddActions(NewISMDeleteAction()),
+//		).
+//		AddISMTemplate(100, "logs-*")
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/policies/
+type ISMPolicy struct {
+	// ID of the policy
+	ID string
+
+	// Description of the policy
+	Description string
+
+	// DefaultState is the state the managed indices start in
+	DefaultState string
+
+	// States of the policy
+	States []*ISMState
+
+	// ISMTemplates apply the policy to the newly created indices matching their patterns
+	ISMTemplates []*ISMTemplate
+}
+
+// NewISMPolicy instantiates an ISMPolicy with the given ID, starting in the given default state.
+func NewISMPolicy(id, defaultState string) *ISMPolicy {
+	return &ISMPolicy{
+		ID:           id,
+		DefaultState: defaultState,
+	}
+}
+
+// WithDescription sets the description of the policy
+func (p *ISMPolicy) WithDescription(description string) *ISMPolicy {
+	p.Description = description
+	return p
+}
+
+// AddStates adds states to the policy
+func (p *ISMPolicy) AddStates(states ...*ISMState) *ISMPolicy {
+	p.States = append(p.States, states...)
+	return p
+}
+
+// AddISMTemplate applies the policy to the newly created indices matching the patterns, with the given priority
+// over the other policies matching them
+func (p *ISMPolicy) AddISMTemplate(priority int, indexPatterns ...string) *ISMPolicy {
+	p.ISMTemplates = append(p.ISMTemplates, &ISMTemplate{
+		IndexPatterns: indexPatterns,
+		Priority:      priority,
+	})
+	return p
+}
+
+// ISMTemplate applies an [ISMPolicy] to the newly created indices matching its patterns.
+type ISMTemplate struct {
+	// IndexPatterns of the names of the indices the policy applies to
+	IndexPatterns []string
+
+	// Priority of the policy over the other policies matching the index
+	Priority int
+}
+
+// ISMState is a state of an [ISMPolicy].
+type ISMState struct {
+	// Name of the state
+	Name string
+
+	// Actions run in order when entering the state
+	Actions []*ISMAction
+
+	// Transitions to other states, the first one whose conditions are met is taken
+	Transitions []*ISMTransition
+}
+
+// NewISMState instantiates an ISMState with the given name.
+func NewISMState(name string) *ISMState {
+	return &ISMState{
+		Name: name,
+	}
+}
+
+// AddActions adds actions to the state
+func (s *ISMState) AddActions(actions ...*ISMAction) *ISMState {
+	s.Actions = append(s.Actions, actions...)
+	return s
+}
+
+// AddTransitions adds transitions to the state
+func (s *ISMState) AddTransitions(transitions ...*ISMTransition) *ISMState {
+	s.Transitions = append(s.Transitions, transitions...)
+	return s
+}
+
+// ISMActionType is the type of an [ISMAction].
+type ISMActionType string
+
+const (
+	// ISMRollover rolls the alias of the index over to a new index
+	ISMRollover ISMActionType = "rollover"
+	// ISMDelete deletes the index
+	ISMDelete ISMActionType = "delete"
+	// ISMReplicaCount sets the number of replicas of the index
+	ISMReplicaCount ISMActionType = "replica_count"
+	// ISMForceMerge merges the segments of the index
+	ISMForceMerge ISMActionType = "force_merge"
+)
+
+// ISMAction is an action run by an [ISMState].
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/policies/#actions
+type ISMAction struct {
+	// Type of the action
+	Type ISMActionType
+
+	// MinSize of the index for an [ISMRollover] action, such as 50gb
+	MinSize string
+
+	// MinPrimaryShardSize of the largest primary shard of the index for an [ISMRollover] action
+	MinPrimaryShardSize string
+
+	// MinDocCount of the index for an [ISMRollover] action
+	MinDocCount int64
+
+	// MinIndexAge of the index for an [ISMRollover] action
+	MinIndexAge time.Duration
+
+	// NumberOfReplicas of the index for an [ISMReplicaCount] action
+	NumberOfReplicas int
+
+	// MaxNumSegments of the index for an [ISMForceMerge] action
+	MaxNumSegments int
+
+	// Parameters of the action types without typed fields, such as read_only or index_priority
+	Parameters map[string]any
+
+	// Timeout of the action. Omitted if zero.
+	Timeout time.Duration
+
+	// Retry of the action when it fails. Omitted if nil.
+	Retry *ISMRetry
+}
+
+// NewISMAction instantiates an ISMAction of a type without typed fields, with the given parameters.
+func NewISMAction(actionType ISMActionType, parameters map[string]any) *ISMAction {
+	return &ISMAction{
+		Type:       actionType,
+		Parameters: parameters,
+	}
+}
+
+// NewISMRolloverAction instantiates an ISMAction rolling the alias of the index over.
+// With no conditions, the index is rolled over as soon as the action runs.
+func NewISMRolloverAction() *ISMAction {
+	return &ISMAction{
+		Type: ISMRollover,
+	}
+}
+
+// NewISMDeleteAction instantiates an ISMAction deleting the index.
+func NewISMDeleteAction() *ISMAction {
+	return &ISMAction{
+		Type: ISMDelete,
+	}
+}
+
+// NewISMReplicaCountAction instantiates an ISMAction setting the number of replicas of the index.
+func NewISMReplicaCountAction(numberOfReplicas int) *ISMAction {
+	return &ISMAction{
+		Type:             ISMReplicaCount,
+		NumberOfReplicas: numberOfReplicas,
+	}
+}
+
+// NewISMForceMergeAction instantiates an ISMAction merging the index down to the given number of segments.
+func NewISMForceMergeAction(maxNumSegments int) *ISMAction {
+	return &ISMAction{
+		Type:           ISMForceMerge,
+		MaxNumSegments: maxNumSegments,
+	}
+}
+
+// WithMinSize sets the minimum size of the index to roll over
+func (a *ISMAction) WithMinSize(minSize string) *ISMAction {
+	a.MinSize = minSize
+	return a
+}
+
+// WithMinPrimaryShardSize sets the minimum size of the largest primary shard of the index to roll over
+func (a *ISMAction) WithMinPrimaryShardSize(minPrimaryShardSize string) *ISMAction {
+	a.MinPrimaryShardSize = minPrimaryShardSize
+	return a
+}
+
+// WithMinDocCount sets the minimum number of documents of the index to roll over
+func (a *ISMAction) WithMinDocCount(minDocCount int64) *ISMAction {
+	a.MinDocCount = minDocCount
+	return a
+}
+
+// WithMinIndexAge sets the minimum age of the index to roll over
+func (a *ISMAction) WithMinIndexAge(minIndexAge time.Duration) *ISMAction {
+	a.MinIndexAge = minIndexAge
+	return a
+}
+
+// WithTimeout sets the timeout of the action
+func (a *ISMAction) WithTimeout(timeout time.Duration) *ISMAction {
+	a.Timeout = timeout
+	return a
+}
+
+// WithRetry sets how the action is retried when it fails
+func (a *ISMAction) WithRetry(retry *ISMRetry) *ISMAction {
+	a.Retry = retry
+	return a
+}
+
+// ISMBackoff is the backoff policy of an [ISMRetry].
+type ISMBackoff string
+
+const (
+	// ISMBackoffExponential doubles the delay between retries
+	ISMBackoffExponential ISMBackoff = "exponential"
+	// ISMBackoffConstant keeps the delay between retries constant
+	ISMBackoffConstant ISMBackoff = "constant"
+	// ISMBackoffLinear increases the delay between retries linearly
+	ISMBackoffLinear ISMBackoff = "linear"
+)
+
+// ISMRetry determines how an [ISMAction] is retried when it fails.
+type ISMRetry struct {
+	// Count of retries
+	Count int
+
+	// Backoff policy of the delay between retries
+	Backoff ISMBackoff
+
+	// Delay between retries. Omitted if zero.
+	Delay time.Duration
+}
+
+// ISMTransition moves a managed index to another [ISMState] once all its conditions are met.
+// A transition with no conditions is taken as soon as the actions of the state are done.
+type ISMTransition struct {
+	// StateName of the state to move to
+	StateName string
+
+	// MinIndexAge of the index. Omitted if zero.
+	MinIndexAge time.Duration
+
+	// MinDocCount of the index. Omitted if zero.
+	MinDocCount int64
+
+	// MinSize of the index, such as 50gb. Omitted if empty.
+	MinSize string
+
+	// MinRolloverAge is the minimum time since the index was rolled over. Omitted if zero.
+	MinRolloverAge time.Duration
+}
+
+// NewISMTransition instantiates an ISMTransition to the state with the given name.
+func NewISMTransition(stateName string) *ISMTransition {
+	return &ISMTransition{
+		StateName: stateName,
+	}
+}
+
+// WithMinIndexAge sets the minimum age of the index
+func (t *ISMTransition) WithMinIndexAge(minIndexAge time.Duration) *ISMTransition {
+	t.MinIndexAge = minIndexAge
+	return t
+}
+
+// WithMinDocCount sets the minimum number of documents of the index
+func (t *ISMTransition) WithMinDocCount(minDocCount int64) *ISMTransition {
+	t.MinDocCount = minDocCount
+	return t
+}
+
+// WithMinSize sets the minimum size of the index
+func (t *ISMTransition) WithMinSize(minSize string) *ISMTransition {
+	t.MinSize = minSize
+	return t
+}
+
+// WithMinRolloverAge sets the minimum time since the index was rolled over
+func (t *ISMTransition) WithMinRolloverAge(minRolloverAge time.Duration) *ISMTransition {
+	t.MinRolloverAge = minRolloverAge
+	return t
+}
+
+// PutISMPolicyRequest is a domain model union type for all the fields of a Put ISM Policy request
+// for all supported OpenSearch versions. It creates the policy, or updates it if IfSeqNo and IfPrimaryTerm are set.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This PutISMPolicyRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	putReq := NewPutISMPolicyRequest(policy)
+//	putResp, err := osv2Executor.PutISMPolicy(ctx, putReq)
+type PutISMPolicyRequest struct {
+	// Policy to create or update
+	Policy *ISMPolicy
+
+	// IfSeqNo only updates the policy if it has this sequence number
+	IfSeqNo uint64
+
+	// IfPrimaryTerm only updates the policy if it has this primary term
+	IfPrimaryTerm uint64
+}
+
+// NewPutISMPolicyRequest instantiates a PutISMPolicyRequest for the given policy.
+func NewPutISMPolicyRequest(policy *ISMPolicy) *PutISMPolicyRequest {
+	return &PutISMPolicyRequest{
+		Policy: policy,
+	}
+}
+
+// WithIfSeqNoPrimaryTerm updates the policy, only if it has the provided sequence number and primary term
+func (r *PutISMPolicyRequest) WithIfSeqNoPrimaryTerm(seqNo, primaryTerm uint64) *PutISMPolicyRequest {
+	r.IfSeqNo = seqNo
+	r.IfPrimaryTerm = primaryTerm
+	return r
+}
+
+// GetISMPolicyRequest is a domain model union type for all the fields of a Get ISM Policy request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetISMPolicyRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetISMPolicyRequest("logs")
+//	getResp, err := osv2Executor.GetISMPolicy(ctx, getReq)
+type GetISMPolicyRequest struct {
+	// PolicyID of the policy to get
+	PolicyID string
+}
+
+// NewGetISMPolicyRequest instantiates a GetISMPolicyRequest for the policy with the given ID.
+func NewGetISMPolicyRequest(policyID string) *GetISMPolicyRequest {
+	return &GetISMPolicyRequest{
+		PolicyID: policyID,
+	}
+}
+
+// ISMPolicyResponse is a domain model union response type for PutISMPolicyRequest and GetISMPolicyRequest
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// SeqNo and PrimaryTerm are used to update the policy with [PutISMPolicyRequest.WithIfSeqNoPrimaryTerm].
+type ISMPolicyResponse struct {
+	ID          string
+	Version     uint64
+	SeqNo       uint64
+	PrimaryTerm uint64
+	Policy      *ISMPolicy
+	Error       *Error
+}
+
+// DeleteISMPolicyRequest is a domain model union type for all the fields of a Delete ISM Policy request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DeleteISMPolicyRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteReq := NewDeleteISMPolicyRequest("logs")
+//	deleteResp, err := osv2Executor.DeleteISMPolicy(ctx, deleteReq)
+type DeleteISMPolicyRequest struct {
+	// PolicyID of the policy to delete
+	PolicyID string
+}
+
+// NewDeleteISMPolicyRequest instantiates a DeleteISMPolicyRequest for the policy with the given ID.
+func NewDeleteISMPolicyRequest(policyID string) *DeleteISMPolicyRequest {
+	return &DeleteISMPolicyRequest{
+		PolicyID: policyID,
+	}
+}
+
+// DeleteISMPolicyResponse is a domain model union response type for DeleteISMPolicyRequest
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type DeleteISMPolicyResponse struct {
+	ID      string
+	Version uint64
+	Result  string
+	Error   *Error
+}
+
+// AddISMPolicyRequest is a domain model union type for all the fields of an Add ISM Policy request
+// for all supported OpenSearch versions. It applies a policy to indices which aren't managed yet.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This AddISMPolicyRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	addReq := NewAddISMPolicyRequest("logs", "logs-*")
+//	addResp, err := osv2Executor.AddISMPolicy(ctx, addReq)
+type AddISMPolicyRequest struct {
+	// PolicyID of the policy to apply
+	PolicyID string
+
+	// Indices to manage, wildcards are supported
+	Indices []string
+}
+
+// NewAddISMPolicyRequest instantiates an AddISMPolicyRequest applying the policy with the given ID to the indices.
+func NewAddISMPolicyRequest(policyID string, indices ...string) *AddISMPolicyRequest {
+	return &AddISMPolicyRequest{
+		PolicyID: policyID,
+		Indices:  indices,
+	}
+}
+
+// RemoveISMPolicyRequest is a domain model union type for all the fields of a Remove ISM Policy request
+// for all supported OpenSearch versions. The indices are no longer managed.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This RemoveISMPolicyRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	removeReq := NewRemoveISMPolicyRequest("logs-000001")
+//	removeResp, err := osv2Executor.RemoveISMPolicy(ctx, removeReq)
+type RemoveISMPolicyRequest struct {
+	// Indices to stop managing, wildcards are supported
+	Indices []string
+}
+
+// NewRemoveISMPolicyRequest instantiates a RemoveISMPolicyRequest for the given indices.
+func NewRemoveISMPolicyRequest(indices ...string) *RemoveISMPolicyRequest {
+	return &RemoveISMPolicyRequest{
+		Indices: indices,
+	}
+}
+
+// ChangeISMPolicyRequest is a domain model union type for all the fields of a Change ISM Policy request
+// for all supported OpenSearch versions. The managed indices switch to the policy once their current state is done.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This ChangeISMPolicyRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	changeReq := NewChangeISMPolicyRequest("logs_v2", "logs-*").WithState("warm")
+//	changeResp, err := osv2Executor.ChangeISMPolicy(ctx, changeReq)
+type ChangeISMPolicyRequest struct {
+	// PolicyID of the policy to switch to
+	PolicyID string
+
+	// Indices whose policy to change, wildcards are supported
+	Indices []string
+
+	// State of the new policy the indices move to. The current state of the indices if empty.
+	State string
+
+	// IncludeStates limits the change to the indices currently in one of these states. All indices if empty.
+	IncludeStates []string
+}
+
+// NewChangeISMPolicyRequest instantiates a ChangeISMPolicyRequest switching the indices to the policy with the given ID.
+func NewChangeISMPolicyRequest(policyID string, indices ...string) *ChangeISMPolicyRequest {
+	return &ChangeISMPolicyRequest{
+		PolicyID: policyID,
+		Indices:  indices,
+	}
+}
+
+// WithState sets the state of the new policy the indices move to
+func (r *ChangeISMPolicyRequest) WithState(state string) *ChangeISMPolicyRequest {
+	r.State = state
+	return r
+}
+
+// WithIncludeStates limits the change to the indices currently in one of the states
+func (r *ChangeISMPolicyRequest) WithIncludeStates(states ...string) *ChangeISMPolicyRequest {
+	r.IncludeStates = states
+	return r
+}
+
+// ISMIndicesResponse is a domain model union response type for AddISMPolicyRequest, RemoveISMPolicyRequest and
+// ChangeISMPolicyRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type ISMIndicesResponse struct {
+	// UpdatedIndices is the number of indices updated
+	UpdatedIndices int
+
+	// FailedIndices are the indices which couldn't be updated
+	FailedIndices []ISMFailedIndex
+
+	// Error of the request
+	Error *Error
+}
+
+// ISMFailedIndex is an index which couldn't be updated in an [ISMIndicesResponse].
+type ISMFailedIndex struct {
+	Index     string
+	IndexUUID string
+	Reason    string
+}
+
+// ExplainISMRequest is a domain model union type for all the fields of an Explain ISM request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This ExplainISMRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	explainReq := NewExplainISMRequest("logs-*")
+//	explainResp, err := osv2Executor.ExplainISM(ctx, explainReq)
+type ExplainISMRequest struct {
+	// Indices to explain, wildcards are supported
+	Indices []string
+}
+
+// NewExplainISMRequest instantiates an ExplainISMRequest for the given indices.
+func NewExplainISMRequest(indices ...string) *ExplainISMRequest {
+	return &ExplainISMRequest{
+		Indices: indices,
+	}
+}
+
+// ExplainISMResponse is a domain model union response type for ExplainISMRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type ExplainISMResponse struct {
+	// Indices explained, by index name
+	Indices map[string]*ISMExplanation
+
+	// TotalManagedIndices is the number of explained indices managed by a policy
+	TotalManagedIndices int
+
+	// Error of the request
+	Error *Error
+}
+
+// ISMExplanation is the management status of an index in an [ExplainISMResponse].
+// PolicyID is empty if the index isn't managed. The state, action and step are empty until the policy is initialized.
+type ISMExplanation struct {
+	// PolicyID of the policy managing the index
+	PolicyID string
+
+	// Enabled reports whether the management of the index is enabled
+	Enabled bool
+
+	// RolledOver reports whether the index was rolled over
+	RolledOver bool
+
+	// State the index is in
+	State string
+
+	// StateStartTime is when the index entered its state
+	StateStartTime time.Time
+
+	// Action being run on the index
+	Action string
+
+	// ActionStartTime is when the action started
+	ActionStartTime time.Time
+
+	// Failed reports whether the action failed
+	Failed bool
+
+	// ConsumedRetries of the action
+	ConsumedRetries int
+
+	// Step of the action being run
+	Step string
+
+	// StepStatus of the step, such as condition_not_met or completed
+	StepStatus string
+
+	// Info holds details about the status, such as a message
+	Info map[string]any
+}
@@ -0,0 +1,364 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// GetMapping defines a method which knows how to make an OpenSearch [Get Mapping] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get Mapping]: https://opensearch.org/docs/latest/field-types/index/#get-a-mapping
+type GetMapping interface {
+	GetMapping(ctx context.Context, req *GetMappingRequest) (OpenSearchResponse[GetMappingResponse], error)
+}
+
+// PutMapping defines a method which knows how to make an OpenSearch [Put Mapping] request.
+// It should be implemented by a version-specific executor.
+//
+// [Put Mapping]: https://opensearch.org/docs/latest/api-reference/index-apis/put-mapping/
+type PutMapping interface {
+	PutMapping(ctx context.Context, req *PutMappingRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// Dynamic determines how fields missing from a mapping are handled when indexing documents.
+type Dynamic string
+
+const (
+	// DynamicTrue - default, new fields are added to the mapping
+	DynamicTrue Dynamic = "true"
+	// DynamicFalse - new fields are stored in the source but not indexed nor added to the mapping
+	DynamicFalse Dynamic = "false"
+	// DynamicStrict - documents with new fields are rejected
+	DynamicStrict Dynamic = "strict"
+)
+
+// FieldType is the type of a mapped field.
+//
+// For more details see https://opensearch.org/docs/latest/field-types/supported-field-types/index/
+type FieldType string
+
+// The field types with dedicated parameters on a [Property], other types can be used as a FieldType directly.
+const (
+	FieldTypeKeyword     FieldType = "keyword"
+	FieldTypeText        FieldType = "text"
+	FieldTypeLong        FieldType = "long"
+	FieldTypeInteger     FieldType = "integer"
+	FieldTypeShort       FieldType = "short"
+	FieldTypeByte        FieldType = "byte"
+	FieldTypeDouble      FieldType = "double"
+	FieldTypeFloat       FieldType = "float"
+	FieldTypeHalfFloat   FieldType = "half_float"
+	FieldTypeScaledFloat FieldType = "scaled_float"
+	FieldTypeBoolean     FieldType = "boolean"
+	FieldTypeDate        FieldType = "date"
+	FieldTypeObject      FieldType = "object"
+	FieldTypeNested      FieldType = "nested"
+	FieldTypeGeoPoint    FieldType = "geo_point"
+	FieldTypeKNNVector   FieldType = "knn_vector"
+)
+
+// Mapping is a domain model union type for the mapping of an index for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// For example:
+//
+//	mapping := NewMapping().
+//		WithDynamic(DynamicStrict).
+//		AddProperty("title", NewTextProperty().WithAnalyzer("english").AddField("raw", NewKeywordProperty())).
+//		AddProperty("created", NewDateProperty().WithFormat("strict_date_optional_time"))
+//
+// For more details see https://opensearch.org/docs/latest/field-types/index/
+type Mapping struct {
+	// Dynamic determines how new fields of the documents are handled. Omitted if empty.
+	Dynamic Dynamic
+
+	// Properties are the mapped fields, by name
+	Properties map[string]*Property
+}
+
+// NewMapping instantiates an empty Mapping.
+func NewMapping() *Mapping {
+	return &Mapping{}
+}
+
+// WithDynamic sets how new fields of the documents are handled
+func (m *Mapping) WithDynamic(dynamic Dynamic) *Mapping {
+	m.Dynamic = dynamic
+	return m
+}
+
+// AddProperty maps the field with the given name
+func (m *Mapping) AddProperty(name string, property *Property) *Mapping {
+	if m.Properties == nil {
+		m.Properties = make(map[string]*Property)
+	}
+
+	m.Properties[name] = property
+	return m
+}
+
+// Property is a domain model union type for a mapped field for all supported OpenSearch versions.
+// Only the parameters relevant to its Type should be set.
+// Currently supported versions are:
+//   - OpenSearch 2
+type Property struct {
+	// Type of the field, object if empty and Properties are set
+	Type FieldType
+
+	// Analyzer used to analyze a text field when indexing and searching
+	Analyzer string
+
+	// SearchAnalyzer overrides the Analyzer when searching
+	SearchAnalyzer string
+
+	// Normalizer applied to a keyword field
+	Normalizer string
+
+	// Index - when false, the field isn't searchable. Omitted if nil.
+	Index *bool
+
+	// DocValues - when false, the field can't be used in sorts and aggregations. Omitted if nil.
+	DocValues *bool
+
+	// IgnoreAbove is the length above which keywords aren't indexed. Omitted if zero.
+	IgnoreAbove int
+
+	// Format of a date field
+	Format string
+
+	// ScalingFactor of a scaled_float field. Omitted if zero.
+	ScalingFactor float64
+
+	// Dynamic determines how new fields of an object or nested field are handled. Omitted if empty.
+	Dynamic Dynamic
+
+	// Properties are the sub-fields of an object or nested field, by name
+	Properties map[string]*Property
+
+	// Fields are the multi-fields indexing the same value differently, by name
+	Fields map[string]*Property
+
+	// Dimension of a knn_vector field
+	Dimension int
+
+	// Method used to index a knn_vector field for approximate search
+	Method *KNNMethod
+}
+
+// NewProperty instantiates a Property of the given type.
+func NewProperty(fieldType FieldType) *Property {
+	return &Property{
+		Type: fieldType,
+	}
+}
+
+// NewKeywordProperty instantiates a keyword Property.
+func NewKeywordProperty() *Property {
+	return NewProperty(FieldTypeKeyword)
+}
+
+// NewTextProperty instantiates a text Property.
+func NewTextProperty() *Property {
+	return NewProperty(FieldTypeText)
+}
+
+// NewDateProperty instantiates a date Property.
+func NewDateProperty() *Property {
+	return NewProperty(FieldTypeDate)
+}
+
+// NewObjectProperty instantiates an object Property.
+func NewObjectProperty() *Property {
+	return NewProperty(FieldTypeObject)
+}
+
+// NewNestedProperty instantiates a nested Property.
+func NewNestedProperty() *Property {
+	return NewProperty(FieldTypeNested)
+}
+
+// NewGeoPointProperty instantiates a geo_point Property.
+func NewGeoPointProperty() *Property {
+	return NewProperty(FieldTypeGeoPoint)
+}
+
+// NewKNNVectorProperty instantiates a knn_vector Property with the given dimension.
+func NewKNNVectorProperty(dimension int) *Property {
+	return &Property{
+		Type:      FieldTypeKNNVector,
+		Dimension: dimension,
+	}
+}
+
+// WithAnalyzer sets the analyzer of a text field
+func (p *Property) WithAnalyzer(analyzer string) *Property {
+	p.Analyzer = analyzer
+	return p
+}
+
+// WithSearchAnalyzer sets the search analyzer of a text field
+func (p *Property) WithSearchAnalyzer(analyzer string) *Property {
+	p.SearchAnalyzer = analyzer
+	return p
+}
+
+// WithNormalizer sets the normalizer of a keyword field
+func (p *Property) WithNormalizer(normalizer string) *Property {
+	p.Normalizer = normalizer
+	return p
+}
+
+// WithIndex sets whether the field is searchable
+func (p *Property) WithIndex(index bool) *Property {
+	p.Index = &index
+	return p
+}
+
+// WithDocValues sets whether the field can be used in sorts and aggregations
+func (p *Property) WithDocValues(docValues bool) *Property {
+	p.DocValues = &docValues
+	return p
+}
+
+// WithIgnoreAbove sets the length above which keywords aren't indexed
+func (p *Property) WithIgnoreAbove(ignoreAbove int) *Property {
+	p.IgnoreAbove = ignoreAbove
+	return p
+}
+
+// WithFormat sets the format of a date field
+func (p *Property) WithFormat(format string) *Property {
+	p.Format = format
+	return p
+}
+
+// WithScalingFactor sets the scaling factor of a scaled_float field
+func (p *Property) WithScalingFactor(scalingFactor float64) *Property {
+	p.ScalingFactor = scalingFactor
+	return p
+}
+
+// WithDynamic sets how new fields of an object or nested field are handled
+func (p *Property) WithDynamic(dynamic Dynamic) *Property {
+	p.Dynamic = dynamic
+	return p
+}
+
+// AddProperty maps a sub-field of an object or nested field
+func (p *Property) AddProperty(name string, property *Property) *Property {
+	if p.Properties == nil {
+		p.Properties = make(map[string]*Property)
+	}
+
+	p.Properties[name] = property
+	return p
+}
+
+// AddField adds a multi-field indexing the same value differently
+func (p *Property) AddField(name string, field *Property) *Property {
+	if p.Fields == nil {
+		p.Fields = make(map[string]*Property)
+	}
+
+	p.Fields[name] = field
+	return p
+}
+
+// WithMethod sets the method used to index a knn_vector field
+func (p *Property) WithMethod(method *KNNMethod) *Property {
+	p.Method = method
+	return p
+}
+
+// KNNMethod is the method used to index a knn_vector field for approximate search.
+//
+// For more details see https://opensearch.org/docs/latest/search-plugins/knn/knn-index/#method-definitions
+type KNNMethod struct {
+	// Name of the algorithm, such as hnsw or ivf
+	Name string
+
+	// SpaceType used to compute the distance between vectors, such as l2 or cosinesimil
+	SpaceType string
+
+	// Engine implementing the algorithm, such as nmslib, faiss or lucene
+	Engine string
+
+	// Parameters of the algorithm, such as ef_construction and m
+	Parameters map[string]any
+}
+
+// NewKNNMethod instantiates a KNNMethod with the given algorithm, space type and engine.
+func NewKNNMethod(name, spaceType, engine string) *KNNMethod {
+	return &KNNMethod{
+		Name:      name,
+		SpaceType: spaceType,
+		Engine:    engine,
+	}
+}
+
+// WithParameters sets the parameters of the algorithm
+func (m *KNNMethod) WithParameters(parameters map[string]any) *KNNMethod {
+	m.Parameters = parameters
+	return m
+}
+
+// GetMappingRequest is a domain model union type for all the fields of a Get Mapping request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetMappingRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetMappingRequest("example_index")
+//	getResp, err := osv2Executor.GetMapping(ctx, getReq)
+type GetMappingRequest struct {
+	// Indices whose mapping to get, wildcards are supported. All indices if empty.
+	Indices []string
+}
+
+// NewGetMappingRequest instantiates a GetMappingRequest for the given indices.
+func NewGetMappingRequest(indices ...string) *GetMappingRequest {
+	return &GetMappingRequest{
+		Indices: indices,
+	}
+}
+
+// GetMappingResponse is a domain model union response type for GetMappingRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetMappingResponse struct {
+	// Mappings of the indices, by index name
+	Mappings map[string]*Mapping
+
+	// Error of the request, such as when an index doesn't exist
+	Error *Error
+}
+
+// PutMappingRequest is a domain model union type for all the fields of a Put Mapping request for all
+// supported OpenSearch versions.
+// Fields can be added to an existing mapping, but existing fields can't be changed.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This PutMappingRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	putReq := NewPutMappingRequest(NewMapping().AddProperty("new_field", NewKeywordProperty()), "example_index")
+//	putResp, err := osv2Executor.PutMapping(ctx, putReq)
+type PutMappingRequest struct {
+	// Indices whose mapping to update, wildcards are supported
+	Indices []string
+
+	// Mapping to merge into the existing mappings
+	Mapping *Mapping
+}
+
+// NewPutMappingRequest instantiates a PutMappingRequest merging the mapping into the given indices.
+func NewPutMappingRequest(mapping *Mapping, indices ...string) *PutMappingRequest {
+	return &PutMappingRequest{
+		Indices: indices,
+		Mapping: mapping,
+	}
+}
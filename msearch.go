@@ -0,0 +1,98 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// MSearch defines a method which knows how to make an OpenSearch [Multi-search] request,
+// executing several searches in a single round trip.
+// It should be implemented by a version-specific executor.
+//
+// [Multi-search]: https://opensearch.org/docs/latest/api-reference/multi-search/
+type MSearch interface {
+	MSearch(ctx context.Context, req *MSearchRequest) (OpenSearchResponse[MSearchResponse], error)
+}
+
+// MSearchRequest is a domain model union type for all the fields of a Multi-search request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This MSearchRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	msearchReq := NewMSearchRequest().
+//		Add(NewSearchRequest().AddIndices("example_index").WithQuery(NewTermQuery("field", "value"))).
+//		Add(NewSearchRequest().AddIndices("other_index"))
+//	msearchResp, err := osv2Executor.MSearch(ctx, msearchReq)
+//
+// The results of each search are returned in the order of the searches.
+//
+// An error can be returned if
+//
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+type MSearchRequest struct {
+	// Searches to be executed
+	Searches []*SearchRequest
+
+	// MaxConcurrentSearches limits how many searches are executed concurrently. Omitted if zero.
+	MaxConcurrentSearches int
+}
+
+// NewMSearchRequest instantiates an MSearchRequest executing the given searches.
+func NewMSearchRequest(searches ...*SearchRequest) *MSearchRequest {
+	return &MSearchRequest{
+		Searches: searches,
+	}
+}
+
+// Add a [SearchRequest] to the searches to be executed.
+func (r *MSearchRequest) Add(searches ...*SearchRequest) *MSearchRequest {
+	r.Searches = append(r.Searches, searches...)
+	return r
+}
+
+// WithMaxConcurrentSearches limits how many searches OpenSearch executes concurrently.
+func (r *MSearchRequest) WithMaxConcurrentSearches(n int) *MSearchRequest {
+	r.MaxConcurrentSearches = n
+	return r
+}
+
+// MSearchResponse is a domain model union response type for Multi-search for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// Contains an [MSearchResult] for each search of the request, in the same order.
+type MSearchResponse struct {
+	// Took is the time in milliseconds the multi-search took
+	Took int
+
+	// Responses to each search, in the order of the searches of the request
+	Responses []MSearchResult
+
+	// Error if the whole request failed
+	Error *Error
+}
+
+// MSearchResult is a domain model union type representing the result of an individual search of a
+// Multi-search response for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// Either Response or Error is set.
+type MSearchResult struct {
+	// Status is the HTTP status code of the individual search
+	Status int
+
+	// Response of the search if it succeeded
+	Response *SearchResponse
+
+	// Error if the search failed
+	Error *Error
+}
+
+// Failed reports whether the search failed.
+func (r MSearchResult) Failed() bool {
+	return r.Error != nil
+}
@@ -0,0 +1,364 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// fromDomainAlias copies the given [opensearchtools.Alias], converting its filter with [V2QueryConverter].
+func fromDomainAlias(alias *opensearchtools.Alias) (opensearchtools.Alias, error) {
+	converted := *alias
+	if alias.Filter != nil {
+		filter, cErr := V2QueryConverter(alias.Filter)
+		if cErr != nil {
+			return converted, cErr
+		}
+
+		converted.Filter = filter
+	}
+
+	return converted, nil
+}
+
+// fromDomainAliases copies the given [opensearchtools.Alias]es with [fromDomainAlias], skipping nil aliases.
+func fromDomainAliases(aliases []*opensearchtools.Alias) ([]opensearchtools.Alias, error) {
+	var converted []opensearchtools.Alias
+	for _, alias := range aliases {
+		if alias == nil {
+			continue
+		}
+
+		convertedAlias, cErr := fromDomainAlias(alias)
+		if cErr != nil {
+			return nil, cErr
+		}
+
+		converted = append(converted, convertedAlias)
+	}
+
+	return converted, nil
+}
+
+// aliasSource builds the JSON body of an alias, without its name
+func aliasSource(alias opensearchtools.Alias) (map[string]any, error) {
+	source := make(map[string]any)
+
+	if alias.Filter != nil {
+		filterJSON, jErr := alias.Filter.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		source["filter"] = json.RawMessage(filterJSON)
+	}
+
+	if alias.Routing != "" {
+		source["routing"] = alias.Routing
+	}
+
+	if alias.IndexRouting != "" {
+		source["index_routing"] = alias.IndexRouting
+	}
+
+	if alias.SearchRouting != "" {
+		source["search_routing"] = alias.SearchRouting
+	}
+
+	if alias.IsWriteIndex != nil {
+		source["is_write_index"] = *alias.IsWriteIndex
+	}
+
+	return source, nil
+}
+
+// AliasActionsRequest is a serializable form of [opensearchtools.AliasActionsRequest] specific to
+// the [opensearchapi.IndicesUpdateAliasesRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/alias/
+type AliasActionsRequest struct {
+	// Actions to apply atomically, in order
+	Actions []opensearchtools.AliasAction
+}
+
+// FromDomainAliasActionsRequest creates a new [AliasActionsRequest] from the given [opensearchtools.AliasActionsRequest].
+// The alias filters are converted with [V2QueryConverter].
+func FromDomainAliasActionsRequest(req *opensearchtools.AliasActionsRequest) (AliasActionsRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	actionsRequest := AliasActionsRequest{
+		Actions: make([]opensearchtools.AliasAction, 0, len(req.Actions)),
+	}
+
+	for _, action := range req.Actions {
+		if action.Alias != nil {
+			converted, cErr := fromDomainAlias(action.Alias)
+			if cErr != nil {
+				vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+				return actionsRequest, vrs
+			}
+
+			action.Alias = &converted
+		}
+
+		actionsRequest.Actions = append(actionsRequest.Actions, action)
+	}
+
+	return actionsRequest, vrs
+}
+
+// Validate validates the given AliasActionsRequest
+func (r *AliasActionsRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(r.Actions) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("Actions not set on the AliasActionsRequest", true))
+	}
+
+	for _, action := range r.Actions {
+		switch action.Type {
+		case opensearchtools.AliasAdd, opensearchtools.AliasRemove:
+			if action.Alias == nil || action.Alias.Name == "" {
+				validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Alias name not set on the %s action of the AliasActionsRequest", action.Type), true))
+			}
+		case opensearchtools.AliasRemoveIndex:
+		default:
+			validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Unknown action type %q on the AliasActionsRequest", action.Type), true))
+		}
+
+		if len(action.Indices) == 0 {
+			validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Indices not set on the %s action of the AliasActionsRequest", action.Type), true))
+		}
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the AliasActionsRequest into the JSON shape expected by OpenSearch.
+func (r *AliasActionsRequest) ToOpenSearchJSON() ([]byte, error) {
+	actions := make([]map[string]any, 0, len(r.Actions))
+	for _, action := range r.Actions {
+		source := make(map[string]any)
+
+		if action.Type == opensearchtools.AliasAdd {
+			aliasJSON, jErr := aliasSource(*action.Alias)
+			if jErr != nil {
+				return nil, jErr
+			}
+
+			source = aliasJSON
+		}
+
+		if action.Alias != nil {
+			source["alias"] = action.Alias.Name
+		}
+
+		source["indices"] = action.Indices
+
+		actions = append(actions, map[string]any{
+			string(action.Type): source,
+		})
+	}
+
+	return json.Marshal(map[string]any{
+		"actions": actions,
+	})
+}
+
+// Do executes the [AliasActionsRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *AliasActionsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osResp, rErr := opensearchapi.IndicesUpdateAliasesRequest{
+		Body: bytes.NewReader(bodyBytes),
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// GetAliasesRequest is a serializable form of [opensearchtools.GetAliasesRequest] specific to
+// the [opensearchapi.IndicesGetAliasRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-alias/#manage-aliases
+type GetAliasesRequest struct {
+	// Indices whose aliases to get, wildcards are supported. All indices if empty.
+	Indices []string
+
+	// Aliases to get, wildcards are supported. All aliases if empty.
+	Aliases []string
+}
+
+// FromDomainGetAliasesRequest creates a new [GetAliasesRequest] from the given [opensearchtools.GetAliasesRequest].
+func FromDomainGetAliasesRequest(req *opensearchtools.GetAliasesRequest) (GetAliasesRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetAliasesRequest{
+		Indices: req.Indices,
+		Aliases: req.Aliases,
+	}, vrs
+}
+
+// Do executes the [GetAliasesRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetAliasesResponse] will be returned.
+// OpenSearch responds with a 404 and the aliases which were found when some of the requested aliases are missing.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetAliasesRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetAliasesResponse], error) {
+	osResp, rErr := opensearchapi.IndicesGetAliasRequest{
+		Index: r.Indices,
+		Name:  r.Aliases,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	body, err := decodeResponse[map[string]json.RawMessage](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	var aliasesResp GetAliasesResponse
+	if osResp.IsError() {
+		aliasesResp.Error, err = decodeAliasesError(body["error"])
+		if err != nil {
+			return nil, err
+		}
+
+		delete(body, "error")
+		delete(body, "status")
+	}
+
+	aliasesResp.Aliases = make(map[string]map[string]AliasInfo, len(body))
+	for index, rawAliases := range body {
+		var indexAliases struct {
+			Aliases map[string]AliasInfo `json:"aliases"`
+		}
+
+		if err := json.Unmarshal(rawAliases, &indexAliases); err != nil {
+			return nil, err
+		}
+
+		aliasesResp.Aliases[index] = indexAliases.Aliases
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		aliasesResp,
+	)
+	return &resp, nil
+}
+
+// aliasesMissingErrorType is the type given to the plain error OpenSearch responds with when aliases are missing
+const aliasesMissingErrorType = "aliases_missing_exception"
+
+// decodeAliasesError decodes the error of a get aliases request, which is a plain string when aliases are missing
+func decodeAliasesError(rawErr json.RawMessage) (*Error, error) {
+	if len(rawErr) == 0 {
+		return nil, nil
+	}
+
+	var reason string
+	if err := json.Unmarshal(rawErr, &reason); err == nil {
+		return &Error{Type: aliasesMissingErrorType, Reason: reason}, nil
+	}
+
+	var aliasesErr Error
+	if err := json.Unmarshal(rawErr, &aliasesErr); err != nil {
+		return nil, err
+	}
+
+	return &aliasesErr, nil
+}
+
+// AliasInfo represents an alias of an index in the response of a [GetAliasesRequest].
+type AliasInfo struct {
+	Filter        json.RawMessage `json:"filter,omitempty"`
+	IndexRouting  string          `json:"index_routing,omitempty"`
+	SearchRouting string          `json:"search_routing,omitempty"`
+	IsWriteIndex  *bool           `json:"is_write_index,omitempty"`
+}
+
+// toDomain converts this instance of an [AliasInfo] into an [opensearchtools.Alias] with the given name.
+// OpenSearch reports the routing of an alias as distinct index and search routings.
+func (a AliasInfo) toDomain(name string) *opensearchtools.Alias {
+	alias := &opensearchtools.Alias{
+		Name:          name,
+		IndexRouting:  a.IndexRouting,
+		SearchRouting: a.SearchRouting,
+		IsWriteIndex:  a.IsWriteIndex,
+	}
+
+	if len(a.Filter) > 0 {
+		alias.Filter = opensearchtools.NewRawQuery(a.Filter)
+	}
+
+	return alias
+}
+
+// GetAliasesResponse represents the response for a [GetAliasesRequest].
+type GetAliasesResponse struct {
+	Aliases map[string]map[string]AliasInfo
+	Error   *Error
+}
+
+// toDomain converts this instance of a [GetAliasesResponse] into an [opensearchtools.GetAliasesResponse].
+// The aliases of each index are sorted by name.
+func (r GetAliasesResponse) toDomain() opensearchtools.GetAliasesResponse {
+	domainResp := opensearchtools.GetAliasesResponse{
+		Aliases: make(map[string][]*opensearchtools.Alias, len(r.Aliases)),
+	}
+
+	for index, aliases := range r.Aliases {
+		names := make([]string, 0, len(aliases))
+		for name := range aliases {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		domainAliases := make([]*opensearchtools.Alias, 0, len(names))
+		for _, name := range names {
+			domainAliases = append(domainAliases, aliases[name].toDomain(name))
+		}
+
+		domainResp.Aliases[index] = domainAliases
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
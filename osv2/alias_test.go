@@ -0,0 +1,150 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestAliasActionsRequest_ToOpenSearchJSON(t *testing.T) {
+	req, vrs := FromDomainAliasActionsRequest(opensearchtools.NewAliasActionsRequest().
+		RemoveAlias("alias", testIndex1).
+		AddAlias(opensearchtools.NewAlias("alias").
+			WithFilter(opensearchtools.NewTermQuery("field", "value")).
+			WithRouting("1").
+			WithIsWriteIndex(true), testIndex2).
+		RemoveIndex(testIndex1))
+	require.False(t, vrs.IsFatal())
+
+	got, err := req.ToOpenSearchJSON()
+	require.Nil(t, err)
+	require.JSONEq(t, `{"actions": [
+		{"remove": {"indices": ["test_index"], "alias": "alias"}},
+		{"add": {
+			"indices": ["test_index2"],
+			"alias": "alias",
+			"filter": {"term": {"field": "value"}},
+			"routing": "1",
+			"is_write_index": true
+		}},
+		{"remove_index": {"indices": ["test_index"]}}
+	]}`, string(got))
+}
+
+func TestAliasActionsRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.AliasActionsRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewAliasActionsRequest().AddAlias(opensearchtools.NewAlias("alias"), testIndex1),
+		},
+		{
+			name:      "No actions",
+			request:   opensearchtools.NewAliasActionsRequest(),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing alias name",
+			request:   opensearchtools.NewAliasActionsRequest().RemoveAlias("", testIndex1),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing alias",
+			request:   opensearchtools.NewAliasActionsRequest().AddAlias(nil, testIndex1),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing indices",
+			request:   opensearchtools.NewAliasActionsRequest().RemoveIndex(),
+			wantFatal: true,
+		},
+		{
+			name: "Unknown action",
+			request: &opensearchtools.AliasActionsRequest{Actions: []opensearchtools.AliasAction{
+				{Type: "rename", Indices: []string{testIndex1}, Alias: opensearchtools.NewAlias("alias")},
+			}},
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainAliasActionsRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_SwapAlias(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	err := opensearchtools.SwapAlias(context.Background(), executor, "alias", testIndex1, testIndex2)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/_aliases", recorded.Path)
+	require.JSONEq(t, `{"actions": [
+		{"remove": {"indices": ["test_index"], "alias": "alias"}},
+		{"add": {"indices": ["test_index2"], "alias": "alias"}}
+	]}`, string(recorded.Body))
+}
+
+func TestExecutor_SwapAliasMissing(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusNotFound, `{
+		"error": {"type": "aliases_not_found_exception", "reason": "aliases [alias] missing"},
+		"status": 404
+	}`)
+
+	err := opensearchtools.SwapAlias(context.Background(), executor, "alias", testIndex1, testIndex2)
+	require.NotNil(t, err)
+}
+
+func TestExecutor_GetAliases(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"test_index": {"aliases": {
+			"write_alias": {"is_write_index": true},
+			"filtered_alias": {"filter": {"term": {"field": "value"}}, "index_routing": "1", "search_routing": "1"}
+		}},
+		"test_index2": {"aliases": {}}
+	}`)
+
+	resp, err := executor.GetAliases(context.Background(), opensearchtools.NewGetAliasesRequest(testIndex1, testIndex2))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/test_index,test_index2/_alias", recorded.Path)
+
+	require.Nil(t, resp.Response.Error)
+	require.Empty(t, resp.Response.Aliases[testIndex2])
+
+	aliases := resp.Response.Aliases[testIndex1]
+	require.Len(t, aliases, 2)
+	require.Equal(t, "filtered_alias", aliases[0].Name)
+	require.Equal(t, "1", aliases[0].IndexRouting)
+	require.Equal(t, "1", aliases[0].SearchRouting)
+	filterJSON, err := aliases[0].Filter.ToOpenSearchJSON()
+	require.Nil(t, err)
+	require.JSONEq(t, `{"term": {"field": "value"}}`, string(filterJSON))
+	require.Equal(t, opensearchtools.NewAlias("write_alias").WithIsWriteIndex(true), aliases[1])
+}
+
+func TestExecutor_GetAliasesPartiallyMissing(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusNotFound, `{
+		"error": "alias [missing] missing",
+		"status": 404,
+		"test_index": {"aliases": {"alias": {}}}
+	}`)
+
+	resp, err := executor.GetAliases(context.Background(), opensearchtools.NewGetAliasesRequest().WithAliases("alias", "missing"))
+	require.Nil(t, err)
+	require.Equal(t, "/_alias/alias,missing", recorded.Path)
+	require.Equal(t, "alias [missing] missing", resp.Response.Error.Reason)
+	require.Equal(t, map[string][]*opensearchtools.Alias{
+		testIndex1: {opensearchtools.NewAlias("alias")},
+	}, resp.Response.Aliases)
+}
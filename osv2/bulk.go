@@ -1,10 +1,13 @@
 package osv2
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/opensearch-project/opensearch-go/v2"
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
@@ -29,6 +32,39 @@ type BulkRequest struct {
 
 	// ParseResponseItemsOnlyOnFailure determines if the bulk response is parsed all the time or only when errors present in the response
 	ParseResponseItemsOnlyOnFailure bool
+
+	// Pipeline is the ingest pipeline used to preprocess all documents in the request
+	Pipeline string
+
+	// Routing value used to route all actions in the request to a specific shard
+	Routing string
+
+	// Timeout for waiting on active shards and the request to complete. Omitted if zero.
+	Timeout time.Duration
+
+	// WaitForActiveShards is the number of active shard copies required before performing the request, such as "all" or "2"
+	WaitForActiveShards string
+
+	// RequireAlias requires the target index of all actions to be an alias
+	RequireAlias bool
+
+	// DataStream marks Index as a data stream, which only accepts BulkCreate actions
+	DataStream bool
+
+	// Source determines if the document source is returned for update actions, either "true", "false", or a list of fields
+	Source []string
+
+	// SourceIncludes lists the source fields to be returned for update actions
+	SourceIncludes []string
+
+	// SourceExcludes lists the source fields to be excluded for update actions
+	SourceExcludes []string
+
+	// Streaming encodes the request body into an [io.Pipe] while it is sent and decodes the response items
+	// as they are read, so memory use is proportional to a single action rather than the entire request.
+	// Note: the opensearch.Client buffers request bodies to be able to retry them unless it is configured
+	// with DisableRetry, in which case only the response is streamed.
+	Streaming bool
 }
 
 // FromDomainBulkRequest creates a new [BulkRequest] from the given [opensearchtools.BulkRequest/.
@@ -42,6 +78,16 @@ func FromDomainBulkRequest(req *opensearchtools.BulkRequest) (BulkRequest, opens
 		Refresh:                         req.Refresh,
 		Index:                           req.Index,
 		ParseResponseItemsOnlyOnFailure: req.ParseResponseItemsOnlyOnFailure,
+		Pipeline:                        req.Pipeline,
+		Routing:                         req.Routing,
+		Timeout:                         req.Timeout,
+		WaitForActiveShards:             req.WaitForActiveShards,
+		RequireAlias:                    req.RequireAlias,
+		DataStream:                      req.DataStream,
+		Source:                          req.Source,
+		SourceIncludes:                  req.SourceIncludes,
+		SourceExcludes:                  req.SourceExcludes,
+		Streaming:                       req.Streaming,
 	}, vrs
 }
 
@@ -63,11 +109,85 @@ func (r *BulkRequest) Validate() opensearchtools.ValidationResults {
 			(a.Type == opensearchtools.BulkUpdate || a.Type == opensearchtools.BulkDelete) {
 			validationResults.Add(opensearchtools.NewValidationResult("Doc ID is empty", true))
 		}
+
+		// ensure the update body of enveloped update Actions is valid
+		if a.Type == opensearchtools.BulkUpdate && a.Update != nil {
+			validationResults.Extend(a.Update.Body.Validate())
+		}
+
+		// ensure data streams are only written to with BulkCreate Actions
+		targetsDataStream := a.DataStream || (r.DataStream && a.Doc.Index() == "")
+		if targetsDataStream && a.Type != opensearchtools.BulkCreate {
+			validationResults.Add(opensearchtools.NewValidationResult(
+				fmt.Sprintf("Data streams only accept BulkCreate actions, not the Action %s with ID %s",
+					a.Type, a.Doc.ID()), true))
+		}
+
+		validationResults.Extend(validateActionMeta(a))
 	}
 
 	return validationResults
 }
 
+// validateActionMeta ensures the optional metadata of a BulkAction is only used with the BulkActionTypes that support it
+func validateActionMeta(a opensearchtools.BulkAction) opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	unsupported := func(field string) {
+		validationResults.Add(opensearchtools.NewValidationResult(
+			fmt.Sprintf("%s is not supported by the Action %s with ID %s", field, a.Type, a.Doc.ID()), true))
+	}
+
+	isIndexOrDelete := a.Type == opensearchtools.BulkIndex || a.Type == opensearchtools.BulkDelete
+	if (a.Version > 0 || a.VersionType != "") && !isIndexOrDelete {
+		unsupported("Version")
+	}
+
+	if a.VersionType != "" && a.Version == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult(
+			fmt.Sprintf("VersionType %s requires a Version on the Action %s with ID %s", a.VersionType, a.Type, a.Doc.ID()), true))
+	}
+
+	if a.Version > 0 && a.VersionType == "" {
+		validationResults.Add(opensearchtools.NewValidationResult(
+			fmt.Sprintf("internal versioning cannot be used for optimistic concurrency control on the Action %s with ID %s, use IfSeqNo and IfPrimaryTerm",
+				a.Type, a.Doc.ID()), true))
+	}
+
+	if a.IfPrimaryTerm > 0 {
+		if a.Type == opensearchtools.BulkCreate {
+			unsupported("IfSeqNo and IfPrimaryTerm")
+		}
+
+		if a.Version > 0 {
+			validationResults.Add(opensearchtools.NewValidationResult(
+				fmt.Sprintf("IfSeqNo and IfPrimaryTerm cannot be used with Version on the Action %s with ID %s", a.Type, a.Doc.ID()), true))
+		}
+
+		if a.RetryOnConflict > 0 {
+			validationResults.Add(opensearchtools.NewValidationResult(
+				fmt.Sprintf("IfSeqNo and IfPrimaryTerm cannot be used with RetryOnConflict on the Action %s with ID %s", a.Type, a.Doc.ID()), true))
+		}
+	}
+
+	if a.Pipeline != "" && a.Type != opensearchtools.BulkIndex && a.Type != opensearchtools.BulkCreate {
+		unsupported("Pipeline")
+	}
+
+	if a.RequireAlias && a.Type == opensearchtools.BulkDelete {
+		unsupported("RequireAlias")
+	}
+
+	if a.RetryOnConflict > 0 && a.Type != opensearchtools.BulkUpdate {
+		unsupported("RetryOnConflict")
+	}
+
+	return validationResults
+}
+
+// newLine delimits the JSON lines of a BulkRequest
+var newLine = []byte{'\n'}
+
 // NewBulkRequest instantiates an empty BulkRequest
 func NewBulkRequest() *BulkRequest {
 	return &BulkRequest{}
@@ -85,6 +205,66 @@ func (r *BulkRequest) WithIndex(index string) *BulkRequest {
 	return r
 }
 
+// WithPipeline sets the ingest pipeline for the request
+func (r *BulkRequest) WithPipeline(pipeline string) *BulkRequest {
+	r.Pipeline = pipeline
+	return r
+}
+
+// WithRouting sets the routing value for the request
+func (r *BulkRequest) WithRouting(routing string) *BulkRequest {
+	r.Routing = routing
+	return r
+}
+
+// WithTimeout sets the request timeout
+func (r *BulkRequest) WithTimeout(timeout time.Duration) *BulkRequest {
+	r.Timeout = timeout
+	return r
+}
+
+// WithWaitForActiveShards sets the number of active shard copies required before performing the request
+func (r *BulkRequest) WithWaitForActiveShards(waitFor string) *BulkRequest {
+	r.WaitForActiveShards = waitFor
+	return r
+}
+
+// WithRequireAlias sets whether the target index of all actions must be an alias
+func (r *BulkRequest) WithRequireAlias(requireAlias bool) *BulkRequest {
+	r.RequireAlias = requireAlias
+	return r
+}
+
+// WithDataStream sets whether the Index of the request is a data stream
+func (r *BulkRequest) WithDataStream(dataStream bool) *BulkRequest {
+	r.DataStream = dataStream
+	return r
+}
+
+// WithSource sets the source filtering of the documents returned by update actions
+func (r *BulkRequest) WithSource(source ...string) *BulkRequest {
+	r.Source = source
+	return r
+}
+
+// WithSourceIncludes sets the source fields to be returned by update actions
+func (r *BulkRequest) WithSourceIncludes(includes ...string) *BulkRequest {
+	r.SourceIncludes = includes
+	return r
+}
+
+// WithSourceExcludes sets the source fields to be excluded by update actions
+func (r *BulkRequest) WithSourceExcludes(excludes ...string) *BulkRequest {
+	r.SourceExcludes = excludes
+	return r
+}
+
+// WithStreaming sets whether the request body is encoded while it is sent and the response is decoded as it is read
+func (r *BulkRequest) WithStreaming(streaming bool) *BulkRequest {
+	r.Streaming = streaming
+	return r
+}
+
 // ToOpenSearchJSON marshals the BulkRequest into the JSON format expected by OpenSearch.
 // Note: A BulkRequest is multi-line json with new line delimiters. It is not a singular valid json struct.
 // For example:
@@ -92,24 +272,39 @@ func (r *BulkRequest) WithIndex(index string) *BulkRequest {
 //	{ action1 json }
 //	{ action2 json }
 func (r *BulkRequest) ToOpenSearchJSON() ([]byte, error) {
+	bodyBuf := new(bytes.Buffer)
+	if err := r.WriteOpenSearchJSON(bodyBuf); err != nil {
+		return nil, err
+	}
+
+	return bodyBuf.Bytes(), nil
+}
+
+// WriteOpenSearchJSON writes the BulkRequest to w in the new line delimited JSON format expected by OpenSearch,
+// marshaling one action at a time.
+func (r *BulkRequest) WriteOpenSearchJSON(w io.Writer) error {
 	if len(r.Actions) == 0 {
-		return nil, fmt.Errorf("bulk request requires at least one action")
+		return fmt.Errorf("bulk request requires at least one action")
 	}
 
-	bodyBuf := new(bytes.Buffer)
 	for _, op := range r.Actions {
 		jsonLines, jErr := op.MarshalJSONLines()
 		if jErr != nil {
-			return nil, jErr
+			return jErr
 		}
 
 		for _, line := range jsonLines {
-			bodyBuf.Write(line)
-			bodyBuf.WriteRune('\n')
+			if _, err := w.Write(line); err != nil {
+				return err
+			}
+
+			if _, err := w.Write(newLine); err != nil {
+				return err
+			}
 		}
 	}
 
-	return bodyBuf.Bytes(), nil
+	return nil
 }
 
 // Do executes the [BulkRequest] using the provided opensearch.Client.
@@ -125,17 +320,17 @@ func (r *BulkRequest) Do(ctx context.Context, client *opensearch.Client) (*opens
 		return nil, opensearchtools.NewValidationError(vrs)
 	}
 
+	if r.Streaming {
+		return r.doStreaming(ctx, client, vrs)
+	}
+
 	rawBody, jErr := r.ToOpenSearchJSON()
 	if jErr != nil {
 		return nil, jErr
 	}
 
-	osResp, rErr := opensearchapi.BulkRequest{
-		Body:    bytes.NewReader(rawBody),
-		Refresh: string(r.Refresh),
-		Index:   r.Index,
-	}.Do(ctx, client)
-
+	osReq := r.toOpenSearchAPI(bytes.NewReader(rawBody))
+	osResp, rErr := osReq.Do(ctx, client)
 	if rErr != nil {
 		return nil, rErr
 	}
@@ -158,6 +353,70 @@ func (r *BulkRequest) Do(ctx context.Context, client *opensearch.Client) (*opens
 	}, nil
 }
 
+// doStreaming executes the [BulkRequest] while encoding its body into an [io.Pipe],
+// and decodes the response as it is read.
+func (r *BulkRequest) doStreaming(ctx context.Context, client *opensearch.Client, vrs opensearchtools.ValidationResults) (*opensearchtools.OpenSearchResponse[BulkResponse], error) {
+	if len(r.Actions) == 0 {
+		return nil, fmt.Errorf("bulk request requires at least one action")
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		bodyWriter := bufio.NewWriter(pipeWriter)
+		err := r.WriteOpenSearchJSON(bodyWriter)
+		if err == nil {
+			err = bodyWriter.Flush()
+		}
+
+		pipeWriter.CloseWithError(err)
+	}()
+
+	// closing the reader stops the encoding if the request completes before the entire body was sent
+	defer pipeReader.Close()
+
+	osReq := r.toOpenSearchAPI(pipeReader)
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+	defer osResp.Body.Close()
+
+	resp, err := r.decodeResponse(osResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &opensearchtools.OpenSearchResponse[BulkResponse]{
+		StatusCode:        osResp.StatusCode,
+		Header:            osResp.Header,
+		Response:          resp,
+		ValidationResults: vrs,
+	}, nil
+}
+
+// toOpenSearchAPI builds the [opensearchapi.BulkRequest] for the given body
+func (r *BulkRequest) toOpenSearchAPI(body io.Reader) opensearchapi.BulkRequest {
+	osReq := opensearchapi.BulkRequest{
+		Body:                body,
+		Refresh:             string(r.Refresh),
+		Index:               r.Index,
+		Pipeline:            r.Pipeline,
+		Routing:             r.Routing,
+		Timeout:             r.Timeout,
+		WaitForActiveShards: r.WaitForActiveShards,
+		Source:              r.Source,
+		SourceIncludes:      r.SourceIncludes,
+		SourceExcludes:      r.SourceExcludes,
+	}
+
+	if r.RequireAlias {
+		requireAlias := true
+		osReq.RequireAlias = &requireAlias
+	}
+
+	return osReq
+}
+
 // parseResponse parses the response based on the bulk response settings
 func (r *BulkRequest) parseResponse(respBuf []byte) (BulkResponse, error) {
 	resp := BulkResponse{}
@@ -183,6 +442,99 @@ func (r *BulkRequest) parseResponse(respBuf []byte) (BulkResponse, error) {
 	return resp, nil
 }
 
+// decodeResponse incrementally decodes the response body based on the bulk response settings,
+// holding a single item in memory at a time while reading the items.
+func (r *BulkRequest) decodeResponse(body io.Reader) (BulkResponse, error) {
+	var (
+		resp        BulkResponse
+		errorsKnown bool
+	)
+
+	decoder := json.NewDecoder(body)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return BulkResponse{}, err
+	}
+
+	for decoder.More() {
+		keyToken, tErr := decoder.Token()
+		if tErr != nil {
+			return BulkResponse{}, tErr
+		}
+
+		var err error
+		switch keyToken {
+		case "took":
+			err = decoder.Decode(&resp.Took)
+		case "errors":
+			err = decoder.Decode(&resp.Errors)
+			errorsKnown = true
+		case "error":
+			err = decoder.Decode(&resp.Error)
+		case "items":
+			err = decodeItems(decoder, &resp, r.ParseResponseItemsOnlyOnFailure && errorsKnown && !resp.Errors)
+		default:
+			var skipped json.RawMessage
+			err = decoder.Decode(&skipped)
+		}
+
+		if err != nil {
+			return BulkResponse{}, err
+		}
+	}
+
+	if err := expectDelim(decoder, '}'); err != nil {
+		return BulkResponse{}, err
+	}
+
+	// items may be sent before errors, in which case they are only discarded once errors is known
+	if r.ParseResponseItemsOnlyOnFailure && !resp.Errors {
+		resp.Items = nil
+	}
+
+	return resp, nil
+}
+
+// decodeItems decodes the items array into resp one item at a time, or discards them if skip is set.
+func decodeItems(decoder *json.Decoder, resp *BulkResponse, skip bool) error {
+	if err := expectDelim(decoder, '['); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		if skip {
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		var item opensearchtools.ActionResponse
+		if err := decoder.Decode(&item); err != nil {
+			return err
+		}
+
+		resp.Items = append(resp.Items, item)
+	}
+
+	return expectDelim(decoder, ']')
+}
+
+// expectDelim reads the next token from the decoder and returns an error if it isn't the delimiter
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("unexpected token %v in bulk response, expected %v", token, delim)
+	}
+
+	return nil
+}
+
 // BulkResponse wraps the functionality of [opensearchapi.Response] by unmarshalling the api response into
 // a slice of [bulk.ActionResponse].
 type BulkResponse struct {
@@ -2,9 +2,16 @@ package osv2
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/opensearch-project/opensearch-go/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CrowdStrike/opensearchtools"
@@ -214,6 +221,308 @@ func TestParseBulkResponse(t *testing.T) {
 			t.Errorf("unable to parse the response %v", err)
 		}
 		require.Equal(t, tt.want, got)
+
+		decoded, err := req.decodeResponse(bytes.NewReader(tt.jsonBytes))
+		require.Nil(t, err)
+		require.Equal(t, tt.want, decoded)
+	}
+
+}
+
+func TestBulkRequest_DecodeResponse(t *testing.T) {
+	tests := []struct {
+		name          string
+		jsonBytes     string
+		errorResponse bool
+		want          BulkResponse
+		wantErr       bool
+	}{
+		{
+			name:          "Items before errors with unknown fields",
+			jsonBytes:     `{"items": [{"delete": {"_index": "index", "_id": "doc_1", "status": 404, "result": "not_found"}}], "ingest_took": 2, "errors": false, "took": 3}`,
+			errorResponse: false,
+			want: BulkResponse{Took: 3, Items: []opensearchtools.ActionResponse{{
+				Type:   "delete",
+				Index:  "index",
+				ID:     "doc_1",
+				Status: 404,
+				Result: "not_found",
+			}}},
+		},
+		{
+			name:          "Items before errors only on failure",
+			jsonBytes:     `{"items": [{"delete": {"_index": "index", "_id": "doc_1", "status": 200}}], "errors": false, "took": 3}`,
+			errorResponse: true,
+			want:          BulkResponse{Took: 3},
+		},
+		{
+			name:      "Request error",
+			jsonBytes: `{"error": {"type": "illegal_argument_exception", "reason": "bad"}, "status": 400}`,
+			want:      BulkResponse{Error: &Error{Type: "illegal_argument_exception", Reason: "bad"}},
+		},
+		{
+			name:      "Truncated response",
+			jsonBytes: `{"took": 3, "errors": false, "items": [{"index": {"_id": "doc_1"}}`,
+			wantErr:   true,
+		},
+		{
+			name:      "Not an object",
+			jsonBytes: `[]`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := NewBulkRequest()
+			req.ParseResponseItemsOnlyOnFailure = tt.errorResponse
+
+			got, err := req.decodeResponse(strings.NewReader(tt.jsonBytes))
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBulkRequest_WriteOpenSearchJSON(t *testing.T) {
+	req := NewBulkRequest().Add(
+		opensearchtools.NewIndexBulkAction(opensearchtools.NewDocumentRef(testIndex1, testID1)),
+		opensearchtools.NewDeleteBulkAction(testIndex1, testID2),
+	)
+
+	want, err := req.ToOpenSearchJSON()
+	require.Nil(t, err)
+
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		pipeWriter.CloseWithError(req.WriteOpenSearchJSON(pipeWriter))
+	}()
+
+	got, err := io.ReadAll(pipeReader)
+	require.Nil(t, err)
+	require.Equal(t, want, got)
+
+	require.NotNil(t, NewBulkRequest().WriteOpenSearchJSON(io.Discard))
+}
+
+func TestBulkRequest_DoStreaming(t *testing.T) {
+	req := NewBulkRequest().WithStreaming(true).Add(
+		opensearchtools.NewIndexBulkAction(opensearchtools.NewDocumentRef(testIndex1, testID1)),
+		opensearchtools.NewDeleteBulkAction(testIndex1, testID2),
+	)
+
+	want, err := req.ToOpenSearchJSON()
+	require.Nil(t, err)
+
+	var (
+		gotBody          []byte
+		gotContentLength int64
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentLength = r.ContentLength
+		gotBody, _ = io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"took": 3, "errors": true, "items": [
+			{"index": {"_index": "test_index", "_id": "test_id", "result": "created", "status": 201}},
+			{"delete": {"_index": "test_index", "_id": "test_id2", "status": 404, "error": {"type": "not_found"}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	// retries are disabled, otherwise the transport buffers the entire body before sending it
+	client, err := opensearch.NewClient(opensearch.Config{Addresses: []string{server.URL}, DisableRetry: true})
+	require.Nil(t, err)
+
+	resp, err := req.Do(context.Background(), client)
+	require.Nil(t, err)
+
+	require.Equal(t, int64(-1), gotContentLength, "a streamed body has no content length")
+	require.Equal(t, want, gotBody)
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int64(3), resp.Response.Took)
+	require.True(t, resp.Response.Errors)
+	require.Len(t, resp.Response.Items, 2)
+	require.Equal(t, testID1, resp.Response.Items[0].ID)
+	require.Equal(t, 201, resp.Response.Items[0].Status)
+	require.Equal(t, testID2, resp.Response.Items[1].ID)
+	require.Equal(t, 404, resp.Response.Items[1].Status)
+}
+
+func TestBulkRequest_Validate(t *testing.T) {
+	testDoc := opensearchtools.NewDocumentRef(testIndex1, testID1)
+
+	tests := []struct {
+		name      string
+		action    opensearchtools.BulkAction
+		wantFatal bool
+	}{
+		{
+			name:      "Plain index action",
+			action:    opensearchtools.NewIndexBulkAction(testDoc),
+			wantFatal: false,
+		},
+		{
+			name:      "Routing on any action",
+			action:    opensearchtools.NewDeleteBulkAction(testIndex1, testID1).WithRouting("route"),
+			wantFatal: false,
+		},
+		{
+			name:      "External version on index",
+			action:    opensearchtools.NewIndexBulkAction(testDoc).WithVersion(2, "external"),
+			wantFatal: false,
+		},
+		{
+			name:      "Version on update",
+			action:    opensearchtools.NewUpdateBulkAction(testDoc).WithVersion(2, "external"),
+			wantFatal: true,
+		},
+		{
+			name:      "Internal version",
+			action:    opensearchtools.NewIndexBulkAction(testDoc).WithVersion(2, ""),
+			wantFatal: true,
+		},
+		{
+			name:      "Version type without version",
+			action:    opensearchtools.NewDeleteBulkAction(testIndex1, testID1).WithVersion(0, "external"),
+			wantFatal: true,
+		},
+		{
+			name:      "Optimistic concurrency on update",
+			action:    opensearchtools.NewUpdateBulkAction(testDoc).WithIfSeqNoPrimaryTerm(1, 1),
+			wantFatal: false,
+		},
+		{
+			name:      "Optimistic concurrency on create",
+			action:    opensearchtools.NewCreateBulkAction(testDoc).WithIfSeqNoPrimaryTerm(1, 1),
+			wantFatal: true,
+		},
+		{
+			name:      "Optimistic concurrency with retry on conflict",
+			action:    opensearchtools.NewUpdateBulkAction(testDoc).WithIfSeqNoPrimaryTerm(1, 1).WithRetryOnConflict(3),
+			wantFatal: true,
+		},
+		{
+			name:      "Pipeline on create",
+			action:    opensearchtools.NewCreateBulkAction(testDoc).WithPipeline("pipeline"),
+			wantFatal: false,
+		},
+		{
+			name:      "Pipeline on delete",
+			action:    opensearchtools.NewDeleteBulkAction(testIndex1, testID1).WithPipeline("pipeline"),
+			wantFatal: true,
+		},
+		{
+			name:      "Require alias on update",
+			action:    opensearchtools.NewUpdateBulkAction(testDoc).WithRequireAlias(true),
+			wantFatal: false,
+		},
+		{
+			name:      "Require alias on delete",
+			action:    opensearchtools.NewDeleteBulkAction(testIndex1, testID1).WithRequireAlias(true),
+			wantFatal: true,
+		},
+		{
+			name:      "Retry on conflict on index",
+			action:    opensearchtools.NewIndexBulkAction(testDoc).WithRetryOnConflict(3),
+			wantFatal: true,
+		},
+		{
+			name:      "Invalid update body",
+			action:    opensearchtools.NewUpdateRequestBulkAction(opensearchtools.NewUpdateRequest(testIndex1, testID1)),
+			wantFatal: true,
+		},
+		{
+			name:      "Create on data stream",
+			action:    opensearchtools.NewCreateBulkAction(testDoc).WithDataStream(true),
+			wantFatal: false,
+		},
+		{
+			name:      "Index on data stream",
+			action:    opensearchtools.NewIndexBulkAction(testDoc).WithDataStream(true),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewBulkRequest().Add(tt.action)
+			vrs := r.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestBulkRequest_ValidateDataStream(t *testing.T) {
+	tests := []struct {
+		name      string
+		action    opensearchtools.BulkAction
+		wantFatal bool
+	}{
+		{
+			name:      "Create on the data stream of the request",
+			action:    opensearchtools.NewCreateBulkAction(opensearchtools.NewDocumentRef("", "")),
+			wantFatal: false,
+		},
+		{
+			name:      "Index on the data stream of the request",
+			action:    opensearchtools.NewIndexBulkAction(opensearchtools.NewDocumentRef("", testID1)),
+			wantFatal: true,
+		},
+		{
+			name:      "Index on another index",
+			action:    opensearchtools.NewIndexBulkAction(opensearchtools.NewDocumentRef(testIndex1, testID1)),
+			wantFatal: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewBulkRequest().WithIndex("logs-nginx").WithDataStream(true).Add(tt.action)
+			vrs := r.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestFromDomainBulkRequest(t *testing.T) {
+	action := opensearchtools.NewIndexBulkAction(opensearchtools.NewDocumentRef(testIndex1, testID1))
+	domainReq := opensearchtools.NewBulkRequest().
+		Add(action).
+		WithIndex(testIndex2).
+		WithPipeline("pipeline").
+		WithRouting("route").
+		WithTimeout(time.Minute).
+		WithWaitForActiveShards("all").
+		WithRequireAlias(true).
+		WithDataStream(true).
+		WithSource("true").
+		WithSourceIncludes("include").
+		WithSourceExcludes("exclude").
+		WithStreaming(true)
+	domainReq.Refresh = opensearchtools.WaitFor
+
+	want := BulkRequest{
+		Actions:             []opensearchtools.BulkAction{action},
+		Refresh:             opensearchtools.WaitFor,
+		Index:               testIndex2,
+		Pipeline:            "pipeline",
+		Routing:             "route",
+		Timeout:             time.Minute,
+		WaitForActiveShards: "all",
+		RequireAlias:        true,
+		DataStream:          true,
+		Source:              []string{"true"},
+		SourceIncludes:      []string{"include"},
+		SourceExcludes:      []string{"exclude"},
+		Streaming:           true,
 	}
 
+	got, vrs := FromDomainBulkRequest(domainReq)
+	require.Equal(t, 0, vrs.Len())
+	require.Equal(t, want, got)
 }
@@ -0,0 +1,442 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// DeleteByQueryRequest is a serializable form of [opensearchtools.DeleteByQueryRequest] specific to
+// the [opensearchapi.DeleteByQueryRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/delete-by-query/
+type DeleteByQueryRequest struct {
+	// Index(s) to delete documents from
+	Index []string
+
+	// Query matching the documents to delete
+	Query opensearchtools.Query
+
+	// Routing - Value(s) used to route the operation to specific shards
+	Routing []string
+
+	// Conflicts determines what happens on a version conflict, abort if empty
+	Conflicts opensearchtools.Conflicts
+
+	// Slices splits the operation into parallel sub-tasks, or [opensearchtools.SlicesAuto]. Omitted if zero.
+	Slices int
+
+	// RequestsPerSecond throttles the operation, -1 disables throttling. Omitted if zero.
+	RequestsPerSecond int
+
+	// MaxDocs is the maximum number of documents to delete. Omitted if zero.
+	MaxDocs int
+
+	// Refresh the affected shards once the operation completes
+	Refresh bool
+
+	// WaitForCompletion - when false, the operation runs as a task whose ID is returned. Defaults to true if nil.
+	WaitForCompletion *bool
+}
+
+// FromDomainDeleteByQueryRequest creates a new [DeleteByQueryRequest] from the given [opensearchtools.DeleteByQueryRequest].
+// The query is converted with [V2QueryConverter].
+func FromDomainDeleteByQueryRequest(req *opensearchtools.DeleteByQueryRequest) (DeleteByQueryRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	deleteRequest := DeleteByQueryRequest{
+		Index:             req.Index,
+		Routing:           req.Routing,
+		Conflicts:         req.Conflicts,
+		Slices:            req.Slices,
+		RequestsPerSecond: req.RequestsPerSecond,
+		MaxDocs:           req.MaxDocs,
+		Refresh:           req.Refresh,
+		WaitForCompletion: req.WaitForCompletion,
+	}
+
+	if req.Query != nil {
+		query, cErr := V2QueryConverter(req.Query)
+		if cErr != nil {
+			vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+			return deleteRequest, vrs
+		}
+
+		deleteRequest.Query = query
+	}
+
+	return deleteRequest, vrs
+}
+
+// Validate validates the given DeleteByQueryRequest
+func (r *DeleteByQueryRequest) Validate() opensearchtools.ValidationResults {
+	validationResults := validateByQuery("DeleteByQueryRequest", r.Index, r.Slices, r.MaxDocs)
+
+	// deleting every document is more safely done by deleting the index
+	if r.Query == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Query not set on the DeleteByQueryRequest", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the DeleteByQueryRequest into the JSON shape expected by OpenSearch.
+func (r *DeleteByQueryRequest) ToOpenSearchJSON() ([]byte, error) {
+	return byQuerySource(r.Query, nil)
+}
+
+// Do executes the [DeleteByQueryRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [BulkByScrollResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The query fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeleteByQueryRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[BulkByScrollResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.DeleteByQueryRequest{
+		Index:             r.Index,
+		Body:              bytes.NewReader(bodyBytes),
+		Conflicts:         string(r.Conflicts),
+		Routing:           r.Routing,
+		Slices:            slicesParam(r.Slices),
+		WaitForCompletion: r.WaitForCompletion,
+	}
+
+	if r.RequestsPerSecond != 0 {
+		requestsPerSecond := r.RequestsPerSecond
+		osReq.RequestsPerSecond = &requestsPerSecond
+	}
+
+	if r.MaxDocs > 0 {
+		maxDocs := r.MaxDocs
+		osReq.MaxDocs = &maxDocs
+	}
+
+	if r.Refresh {
+		refresh := r.Refresh
+		osReq.Refresh = &refresh
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	byQueryResp, err := decodeResponse[BulkByScrollResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		byQueryResp,
+	)
+	return &resp, nil
+}
+
+// UpdateByQueryRequest is a serializable form of [opensearchtools.UpdateByQueryRequest] specific to
+// the [opensearchapi.UpdateByQueryRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/update-by-query/
+type UpdateByQueryRequest struct {
+	// Index(s) to update documents of
+	Index []string
+
+	// Query matching the documents to update, all documents if nil
+	Query opensearchtools.Query
+
+	// Script to be executed against each document
+	Script *opensearchtools.Script
+
+	// Routing - Value(s) used to route the operation to specific shards
+	Routing []string
+
+	// Conflicts determines what happens on a version conflict, abort if empty
+	Conflicts opensearchtools.Conflicts
+
+	// Slices splits the operation into parallel sub-tasks, or [opensearchtools.SlicesAuto]. Omitted if zero.
+	Slices int
+
+	// RequestsPerSecond throttles the operation, -1 disables throttling. Omitted if zero.
+	RequestsPerSecond int
+
+	// MaxDocs is the maximum number of documents to update. Omitted if zero.
+	MaxDocs int
+
+	// Refresh the affected shards once the operation completes
+	Refresh bool
+
+	// WaitForCompletion - when false, the operation runs as a task whose ID is returned. Defaults to true if nil.
+	WaitForCompletion *bool
+}
+
+// FromDomainUpdateByQueryRequest creates a new [UpdateByQueryRequest] from the given [opensearchtools.UpdateByQueryRequest].
+// The query is converted with [V2QueryConverter].
+func FromDomainUpdateByQueryRequest(req *opensearchtools.UpdateByQueryRequest) (UpdateByQueryRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	updateRequest := UpdateByQueryRequest{
+		Index:             req.Index,
+		Script:            req.Script,
+		Routing:           req.Routing,
+		Conflicts:         req.Conflicts,
+		Slices:            req.Slices,
+		RequestsPerSecond: req.RequestsPerSecond,
+		MaxDocs:           req.MaxDocs,
+		Refresh:           req.Refresh,
+		WaitForCompletion: req.WaitForCompletion,
+	}
+
+	if req.Query != nil {
+		query, cErr := V2QueryConverter(req.Query)
+		if cErr != nil {
+			vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+			return updateRequest, vrs
+		}
+
+		updateRequest.Query = query
+	}
+
+	return updateRequest, vrs
+}
+
+// Validate validates the given UpdateByQueryRequest
+func (r *UpdateByQueryRequest) Validate() opensearchtools.ValidationResults {
+	validationResults := validateByQuery("UpdateByQueryRequest", r.Index, r.Slices, r.MaxDocs)
+
+	if r.Script != nil {
+		validationResults.Extend(r.Script.Validate())
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the UpdateByQueryRequest into the JSON shape expected by OpenSearch.
+func (r *UpdateByQueryRequest) ToOpenSearchJSON() ([]byte, error) {
+	return byQuerySource(r.Query, r.Script)
+}
+
+// Do executes the [UpdateByQueryRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [BulkByScrollResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The query or script fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *UpdateByQueryRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[BulkByScrollResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.UpdateByQueryRequest{
+		Index:             r.Index,
+		Body:              bytes.NewReader(bodyBytes),
+		Conflicts:         string(r.Conflicts),
+		Routing:           r.Routing,
+		Slices:            slicesParam(r.Slices),
+		WaitForCompletion: r.WaitForCompletion,
+	}
+
+	if r.RequestsPerSecond != 0 {
+		requestsPerSecond := r.RequestsPerSecond
+		osReq.RequestsPerSecond = &requestsPerSecond
+	}
+
+	if r.MaxDocs > 0 {
+		maxDocs := r.MaxDocs
+		osReq.MaxDocs = &maxDocs
+	}
+
+	if r.Refresh {
+		refresh := r.Refresh
+		osReq.Refresh = &refresh
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	byQueryResp, err := decodeResponse[BulkByScrollResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		byQueryResp,
+	)
+	return &resp, nil
+}
+
+// validateByQuery validates the fields shared by by query requests
+func validateByQuery(requestName string, index []string, slices, maxDocs int) opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(index) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Index not set on the %s", requestName), true))
+	}
+
+	if slices < 0 && slices != opensearchtools.SlicesAuto {
+		validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Slices must be positive or SlicesAuto on the %s", requestName), true))
+	}
+
+	if maxDocs < 0 {
+		validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("MaxDocs cannot be negative on the %s", requestName), true))
+	}
+
+	return validationResults
+}
+
+// byQuerySource builds the body of a by query request
+func byQuerySource(query opensearchtools.Query, script *opensearchtools.Script) ([]byte, error) {
+	source := make(map[string]any)
+	if query != nil {
+		queryJSON, jErr := query.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		source["query"] = json.RawMessage(queryJSON)
+	}
+
+	if script != nil {
+		scriptJSON, jErr := script.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		source["script"] = json.RawMessage(scriptJSON)
+	}
+
+	return json.Marshal(source)
+}
+
+// slicesParam converts a number of slices into the value of the slices parameter, nil if omitted
+func slicesParam(slices int) any {
+	switch {
+	case slices == opensearchtools.SlicesAuto:
+		return "auto"
+	case slices > 0:
+		return slices
+	default:
+		return nil
+	}
+}
+
+// BulkByScrollResponse represents the response of a bulk by scroll operation, such as a [DeleteByQueryRequest],
+// or its task ID if it was started without waiting for its completion.
+type BulkByScrollResponse struct {
+	Task             string                `json:"task,omitempty"`
+	Took             int64                 `json:"took"`
+	TimedOut         bool                  `json:"timed_out"`
+	Total            int64                 `json:"total"`
+	Created          int64                 `json:"created"`
+	Deleted          int64                 `json:"deleted"`
+	Updated          int64                 `json:"updated"`
+	Batches          int64                 `json:"batches"`
+	VersionConflicts int64                 `json:"version_conflicts"`
+	Noops            int64                 `json:"noops"`
+	Retries          BulkByScrollRetries   `json:"retries"`
+	Failures         []BulkByScrollFailure `json:"failures,omitempty"`
+	Error            *Error                `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [BulkByScrollResponse] into an [opensearchtools.BulkByScrollResponse].
+func (r BulkByScrollResponse) toDomain() opensearchtools.BulkByScrollResponse {
+	domainResp := opensearchtools.BulkByScrollResponse{
+		Task:             r.Task,
+		Took:             r.Took,
+		TimedOut:         r.TimedOut,
+		Total:            r.Total,
+		Created:          r.Created,
+		Deleted:          r.Deleted,
+		Updated:          r.Updated,
+		Batches:          r.Batches,
+		VersionConflicts: r.VersionConflicts,
+		Noops:            r.Noops,
+		Retries: opensearchtools.BulkByScrollRetries{
+			Bulk:   r.Retries.Bulk,
+			Search: r.Retries.Search,
+		},
+	}
+
+	for _, failure := range r.Failures {
+		domainResp.Failures = append(domainResp.Failures, failure.toDomain())
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// BulkByScrollRetries represents the retries attempted by a bulk by scroll operation.
+type BulkByScrollRetries struct {
+	Bulk   int64 `json:"bulk"`
+	Search int64 `json:"search"`
+}
+
+// BulkByScrollFailure represents a failure of a bulk by scroll operation.
+// Document failures have an ID, Status and Cause, while shard failures have a Shard, Node and Reason.
+type BulkByScrollFailure struct {
+	Index  string `json:"index"`
+	ID     string `json:"id"`
+	Status int    `json:"status"`
+	Cause  *Error `json:"cause,omitempty"`
+	Shard  *int   `json:"shard,omitempty"`
+	Node   string `json:"node"`
+	Reason *Error `json:"reason,omitempty"`
+}
+
+// toDomain converts this instance of a [BulkByScrollFailure] into an [opensearchtools.BulkByScrollFailure].
+func (f BulkByScrollFailure) toDomain() opensearchtools.BulkByScrollFailure {
+	domainFailure := opensearchtools.BulkByScrollFailure{
+		Index:  f.Index,
+		ID:     f.ID,
+		Shard:  -1,
+		Node:   f.Node,
+		Status: f.Status,
+	}
+
+	if f.Shard != nil {
+		domainFailure.Shard = *f.Shard
+	}
+
+	switch {
+	case f.Cause != nil:
+		domainFailure.Cause = f.Cause.toDomain()
+	case f.Reason != nil:
+		domainFailure.Cause = f.Reason.toDomain()
+	}
+
+	return domainFailure
+}
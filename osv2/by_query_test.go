@@ -0,0 +1,134 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestDeleteByQueryRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.DeleteByQueryRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewDeleteByQueryRequest(opensearchtools.NewMatchAllQuery(), testIndex1).WithSlices(opensearchtools.SlicesAuto),
+		},
+		{
+			name:      "Missing index",
+			request:   opensearchtools.NewDeleteByQueryRequest(opensearchtools.NewMatchAllQuery()),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing query",
+			request:   opensearchtools.NewDeleteByQueryRequest(nil, testIndex1),
+			wantFatal: true,
+		},
+		{
+			name:      "Invalid slices",
+			request:   opensearchtools.NewDeleteByQueryRequest(opensearchtools.NewMatchAllQuery(), testIndex1).WithSlices(-2),
+			wantFatal: true,
+		},
+		{
+			name:      "Negative max docs",
+			request:   opensearchtools.NewDeleteByQueryRequest(opensearchtools.NewMatchAllQuery(), testIndex1).WithMaxDocs(-1),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainDeleteByQueryRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_DeleteByQuery(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"took": 147, "timed_out": false, "total": 120, "deleted": 119, "batches": 1, "version_conflicts": 1,
+		"noops": 0, "retries": {"bulk": 0, "search": 1}, "failures": [
+			{"index": "test_index", "id": "test_id", "status": 409,
+				"cause": {"type": "version_conflict_engine_exception", "reason": "version conflict"}},
+			{"index": "test_index", "shard": 2, "node": "node_1",
+				"reason": {"type": "es_rejected_execution_exception", "reason": "rejected"}}
+		]}`)
+
+	req := opensearchtools.NewDeleteByQueryRequest(opensearchtools.NewTermQuery("field", "value"), testIndex1, testIndex2).
+		WithConflicts(opensearchtools.ConflictsProceed).
+		WithSlices(opensearchtools.SlicesAuto).
+		WithRequestsPerSecond(500).
+		WithMaxDocs(1000).
+		WithRefresh(true)
+
+	resp, err := executor.DeleteByQuery(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/test_index,test_index2/_delete_by_query", recorded.Path)
+	require.Equal(t, "proceed", recorded.Query.Get("conflicts"))
+	require.Equal(t, "auto", recorded.Query.Get("slices"))
+	require.Equal(t, "500", recorded.Query.Get("requests_per_second"))
+	require.Equal(t, "1000", recorded.Query.Get("max_docs"))
+	require.Equal(t, "true", recorded.Query.Get("refresh"))
+	require.False(t, recorded.Query.Has("wait_for_completion"))
+	require.JSONEq(t, `{"query":{"term":{"field":"value"}}}`, string(recorded.Body))
+
+	require.True(t, resp.Response.Failed())
+	require.Equal(t, opensearchtools.BulkByScrollResponse{
+		Took:             147,
+		Total:            120,
+		Deleted:          119,
+		Batches:          1,
+		VersionConflicts: 1,
+		Retries:          opensearchtools.BulkByScrollRetries{Search: 1},
+		Failures: []opensearchtools.BulkByScrollFailure{
+			{
+				Index:  testIndex1,
+				ID:     testID1,
+				Shard:  -1,
+				Status: http.StatusConflict,
+				Cause:  opensearchtools.Error{Type: "version_conflict_engine_exception", Reason: "version conflict"},
+			},
+			{
+				Index: testIndex1,
+				Shard: 2,
+				Node:  "node_1",
+				Cause: opensearchtools.Error{Type: "es_rejected_execution_exception", Reason: "rejected"},
+			},
+		},
+	}, resp.Response)
+}
+
+func TestExecutor_UpdateByQuery(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"task": "node_1:42"}`)
+
+	req := opensearchtools.NewUpdateByQueryRequest(opensearchtools.NewTermQuery("field", "value"), testIndex1).
+		WithScript(opensearchtools.NewScript("ctx._source.count += params.inc").AddParam("inc", 1)).
+		WithSlices(4).
+		WithWaitForCompletion(false)
+
+	resp, err := executor.UpdateByQuery(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, "/test_index/_update_by_query", recorded.Path)
+	require.Equal(t, "4", recorded.Query.Get("slices"))
+	require.Equal(t, "false", recorded.Query.Get("wait_for_completion"))
+	require.JSONEq(t, `{
+		"query": {"term": {"field": "value"}},
+		"script": {"source": "ctx._source.count += params.inc", "params": {"inc": 1}}
+	}`, string(recorded.Body))
+
+	require.Equal(t, opensearchtools.BulkByScrollResponse{Task: "node_1:42"}, resp.Response)
+}
+
+func TestUpdateByQueryRequest_ToOpenSearchJSON(t *testing.T) {
+	req, _ := FromDomainUpdateByQueryRequest(opensearchtools.NewUpdateByQueryRequest(nil, testIndex1))
+	got, err := req.ToOpenSearchJSON()
+	require.Nil(t, err)
+	require.JSONEq(t, `{}`, string(got))
+}
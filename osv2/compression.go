@@ -0,0 +1,183 @@
+package osv2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchtransport"
+)
+
+// gzipTransport wraps an [opensearchtransport.Interface] to gzip request bodies of at least threshold bytes,
+// and to request and decompress gzip responses.
+type gzipTransport struct {
+	next      opensearchtransport.Interface
+	threshold int
+
+	// skipRequests leaves request bodies uncompressed, as the wrapped transport compresses them itself
+	skipRequests bool
+}
+
+// newGzipTransport wraps the transport, only compressing request bodies if the transport doesn't already
+func newGzipTransport(next opensearchtransport.Interface, threshold int) *gzipTransport {
+	return &gzipTransport{
+		next:         next,
+		threshold:    threshold,
+		skipRequests: compressesRequestBody(next),
+	}
+}
+
+// compressesRequestBody returns true if the transport is an [opensearchtransport.Client] configured with
+// CompressRequestBody, which gzips every request body and would compress an already compressed body again.
+// The setting has no accessor, so the unexported field is read, assuming it isn't set if the field is missing.
+func compressesRequestBody(transport opensearchtransport.Interface) bool {
+	client, ok := transport.(*opensearchtransport.Client)
+	if !ok || client == nil {
+		return false
+	}
+
+	field := reflect.ValueOf(client).Elem().FieldByName("compressRequestBody")
+	return field.IsValid() && field.Kind() == reflect.Bool && field.Bool()
+}
+
+// Metrics implements [opensearchtransport.Measurable] by forwarding to the wrapped transport,
+// so the metrics of the original client remain available through the wrapping client.
+func (t *gzipTransport) Metrics() (opensearchtransport.Metrics, error) {
+	if mt, ok := t.next.(opensearchtransport.Measurable); ok {
+		return mt.Metrics()
+	}
+
+	return opensearchtransport.Metrics{}, errors.New("transport is missing method Metrics()")
+}
+
+// DiscoverNodes implements [opensearchtransport.Discoverable] by forwarding to the wrapped transport,
+// so node discovery of the original client remains available through the wrapping client.
+func (t *gzipTransport) DiscoverNodes() error {
+	if dt, ok := t.next.(opensearchtransport.Discoverable); ok {
+		return dt.DiscoverNodes()
+	}
+
+	return errors.New("transport is missing method DiscoverNodes()")
+}
+
+// Perform implements [opensearchtransport.Interface] by compressing the request body before passing it on
+// to the wrapped transport, and decompressing the response body.
+// Bodies of unknown length, such as streamed bulk requests, are always compressed as they are read.
+func (t *gzipTransport) Perform(req *http.Request) (*http.Response, error) {
+	if t.shouldCompress(req) {
+		if err := t.compressBody(req); err != nil {
+			return nil, err
+		}
+	}
+
+	// setting Accept-Encoding disables the transparent decompression of the http.Transport
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := t.next.Perform(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") && resp.Body != nil && resp.Body != http.NoBody {
+		gzipReader, gErr := gzip.NewReader(resp.Body)
+		if gErr != nil {
+			resp.Body.Close()
+			return nil, gErr
+		}
+
+		resp.Body = &gzipReadCloser{Reader: gzipReader, body: resp.Body}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+	}
+
+	return resp, nil
+}
+
+// shouldCompress returns true if the request has a body that isn't already encoded, of unknown length or
+// of a known length of at least threshold bytes.
+func (t *gzipTransport) shouldCompress(req *http.Request) bool {
+	if t.skipRequests || req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Encoding") != "" {
+		return false
+	}
+
+	if isUnknownLength(req) {
+		return true
+	}
+
+	return req.ContentLength > 0 && req.ContentLength >= int64(t.threshold)
+}
+
+// isUnknownLength returns true if the length of the request body is unknown, such as a body streamed from an [io.Pipe].
+// A zero ContentLength is only unknown if the body can't be recreated, as [http.NewRequest] sets GetBody for known bodies.
+func isUnknownLength(req *http.Request) bool {
+	return req.ContentLength < 0 || (req.ContentLength == 0 && req.GetBody == nil)
+}
+
+// compressBody replaces the request body with its gzip compressed form.
+// Bodies of known length are compressed into memory, others are compressed into an [io.Pipe] as they are sent.
+func (t *gzipTransport) compressBody(req *http.Request) error {
+	body := req.Body
+	req.Header.Set("Content-Encoding", "gzip")
+
+	if isUnknownLength(req) {
+		pipeReader, pipeWriter := io.Pipe()
+		go func() {
+			gzipWriter := gzip.NewWriter(pipeWriter)
+			_, err := io.Copy(gzipWriter, body)
+			if cErr := gzipWriter.Close(); err == nil {
+				err = cErr
+			}
+
+			body.Close()
+			pipeWriter.CloseWithError(err)
+		}()
+
+		req.Body = pipeReader
+		req.ContentLength = 0
+		req.GetBody = nil
+		return nil
+	}
+
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	if _, err := io.Copy(gzipWriter, body); err != nil {
+		return err
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+
+	body.Close()
+
+	compressedBytes := compressed.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(compressedBytes))
+	req.ContentLength = int64(len(compressedBytes))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressedBytes)), nil
+	}
+
+	return nil
+}
+
+// gzipReadCloser reads a decompressed response and closes both the gzip reader and the underlying body.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+// Close implements [io.Closer]
+func (g *gzipReadCloser) Close() error {
+	gErr := g.Reader.Close()
+	if err := g.body.Close(); err != nil {
+		return err
+	}
+
+	return gErr
+}
@@ -0,0 +1,206 @@
+package osv2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchtransport"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+const testBulkResponse = `{"took": 3, "errors": false, "items": [{"index": {"_index": "test_index", "_id": "test_id", "status": 201}}]}`
+
+// compressionServer records the decompressed request body and responds with a gzip compressed bulk response
+// if the request accepts it.
+type compressionServer struct {
+	contentEncoding string
+	body            []byte
+}
+
+func (s *compressionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.contentEncoding = r.Header.Get("Content-Encoding")
+
+	var body io.Reader = r.Body
+	if s.contentEncoding == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body = gzipReader
+	}
+
+	s.body, _ = io.ReadAll(body)
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.Header.Get("Accept-Encoding") != "gzip" {
+		_, _ = w.Write([]byte(testBulkResponse))
+		return
+	}
+
+	w.Header().Set("Content-Encoding", "gzip")
+	gzipWriter := gzip.NewWriter(w)
+	_, _ = gzipWriter.Write([]byte(testBulkResponse))
+	_ = gzipWriter.Close()
+}
+
+func TestExecutor_WithGzipCompression(t *testing.T) {
+	bulkReq := opensearchtools.NewBulkRequest().
+		Add(opensearchtools.NewIndexBulkAction(opensearchtools.NewDocumentRef(testIndex1, testID1)))
+	osv2Req, _ := FromDomainBulkRequest(bulkReq)
+	wantBody, err := osv2Req.ToOpenSearchJSON()
+	require.Nil(t, err)
+
+	tests := []struct {
+		name                string
+		opts                []ExecutorOption
+		streaming           bool
+		compressRequestBody bool
+		wantCompressed      bool
+	}{
+		{
+			name: "No compression",
+		},
+		{
+			name:           "Compressed above threshold",
+			opts:           []ExecutorOption{WithGzipCompression(10)},
+			wantCompressed: true,
+		},
+		{
+			name: "Not compressed below threshold",
+			opts: []ExecutorOption{WithGzipCompression(len(wantBody) + 1)},
+		},
+		{
+			name:           "Streaming body is always compressed",
+			opts:           []ExecutorOption{WithGzipCompression(len(wantBody) + 1)},
+			streaming:      true,
+			wantCompressed: true,
+		},
+		{
+			name:                "Client compressed body is not compressed again",
+			opts:                []ExecutorOption{WithGzipCompression(0)},
+			compressRequestBody: true,
+			wantCompressed:      true,
+		},
+		{
+			name:                "Client compressed streaming body is not compressed again",
+			opts:                []ExecutorOption{WithGzipCompression(0)},
+			streaming:           true,
+			compressRequestBody: true,
+			wantCompressed:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &compressionServer{}
+			httpServer := httptest.NewServer(server)
+			defer httpServer.Close()
+
+			client, cErr := opensearch.NewClient(opensearch.Config{
+				Addresses:           []string{httpServer.URL},
+				CompressRequestBody: tt.compressRequestBody,
+			})
+			require.Nil(t, cErr)
+
+			executor := NewExecutor(client, tt.opts...)
+			resp, bErr := executor.Bulk(context.Background(), bulkReq.WithStreaming(tt.streaming))
+			require.Nil(t, bErr)
+
+			require.Equal(t, tt.wantCompressed, server.contentEncoding == "gzip")
+			require.True(t, bytes.Equal(wantBody, server.body))
+			require.Equal(t, int64(3), resp.Response.Took)
+			require.Len(t, resp.Response.Items, 1)
+			require.Equal(t, testID1, resp.Response.Items[0].ID)
+		})
+	}
+}
+
+func TestExecutor_WithGzipCompressionKeepsClientFeatures(t *testing.T) {
+	client, err := opensearch.NewClient(opensearch.Config{Addresses: []string{"http://localhost:9200"}, EnableMetrics: true})
+	require.Nil(t, err)
+
+	executor := NewExecutor(client, WithGzipCompression(0))
+
+	metrics, err := executor.Client.Metrics()
+	require.Nil(t, err)
+	require.Len(t, metrics.Connections, 1)
+
+	_, isDiscoverable := executor.Client.Transport.(opensearchtransport.Discoverable)
+	require.True(t, isDiscoverable)
+}
+
+// recordingTransport records the last request it performs and responds with an empty 200
+type recordingTransport struct {
+	req *http.Request
+}
+
+func (r *recordingTransport) Perform(req *http.Request) (*http.Response, error) {
+	r.req = req
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+}
+
+func TestGzipTransport_Perform(t *testing.T) {
+	// the closed writer ends the compression of the unknown length body
+	pipeReader, pipeWriter := io.Pipe()
+	require.Nil(t, pipeWriter.Close())
+
+	tests := []struct {
+		name           string
+		req            func() *http.Request
+		wantCompressed bool
+	}{
+		{
+			name: "Known length body",
+			req: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(`{}`)))
+				return req
+			},
+			wantCompressed: true,
+		},
+		{
+			name: "Unknown length body",
+			req: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodPost, "/", pipeReader)
+				return req
+			},
+			wantCompressed: true,
+		},
+		{
+			name: "Known empty body",
+			req: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader(nil))
+				req.Body = io.NopCloser(bytes.NewReader(nil))
+				return req
+			},
+			wantCompressed: false,
+		},
+		{
+			name: "Already encoded body",
+			req: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(`{}`)))
+				req.Header.Set("Content-Encoding", "deflate")
+				return req
+			},
+			wantCompressed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &recordingTransport{}
+			_, err := newGzipTransport(next, 0).Perform(tt.req())
+			require.Nil(t, err)
+			require.Equal(t, tt.wantCompressed, next.req.Header.Get("Content-Encoding") == "gzip")
+		})
+	}
+}
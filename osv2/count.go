@@ -0,0 +1,146 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// CountRequest is a serializable form of [opensearchtools.CountRequest] specific to
+// the [opensearchapi.CountRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/count/
+type CountRequest struct {
+	// Query matching the documents to count, all documents if nil
+	Query opensearchtools.Query
+
+	// Index(s) to be targeted by the count
+	Index []string
+
+	// Routing - Value(s) used to route the count operation to specific shards
+	Routing []string
+}
+
+// NewCountRequest instantiates an empty CountRequest, counting all documents of all indices.
+func NewCountRequest() *CountRequest {
+	return &CountRequest{}
+}
+
+// AddIndices adds indices to the index list of the request.
+func (r *CountRequest) AddIndices(indices ...string) *CountRequest {
+	r.Index = append(r.Index, indices...)
+	return r
+}
+
+// WithQuery matching the documents to be counted.
+func (r *CountRequest) WithQuery(q opensearchtools.Query) *CountRequest {
+	r.Query = q
+	return r
+}
+
+// WithRouting sets the routing value(s).
+func (r *CountRequest) WithRouting(routing ...string) *CountRequest {
+	r.Routing = routing
+	return r
+}
+
+// FromDomainCountRequest creates a new [CountRequest] from the given [opensearchtools.CountRequest].
+// The query is converted with [V2QueryConverter].
+func FromDomainCountRequest(req *opensearchtools.CountRequest) (CountRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	countRequest := CountRequest{
+		Index:   req.Index,
+		Routing: req.Routing,
+	}
+
+	if req.Query != nil {
+		query, cErr := V2QueryConverter(req.Query)
+		if cErr != nil {
+			vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+			return countRequest, vrs
+		}
+
+		countRequest.Query = query
+	}
+
+	return countRequest, vrs
+}
+
+// ToOpenSearchJSON marshals the CountRequest into the JSON shape expected by OpenSearch.
+func (r *CountRequest) ToOpenSearchJSON() ([]byte, error) {
+	source := make(map[string]any)
+	if r.Query != nil {
+		queryJSON, jErr := r.Query.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		source["query"] = json.RawMessage(queryJSON)
+	}
+
+	return json.Marshal(source)
+}
+
+// Do executes the [CountRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [CountResponse] will be returned.
+// An error can be returned if
+//
+//   - The query fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *CountRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[CountResponse], error) {
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osResp, rErr := opensearchapi.CountRequest{
+		Index:   r.Index,
+		Body:    bytes.NewReader(bodyBytes),
+		Routing: r.Routing,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	countResp, err := decodeResponse[CountResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		countResp,
+	)
+	return &resp, nil
+}
+
+// CountResponse represents the response for a [CountRequest].
+type CountResponse struct {
+	Count  int64     `json:"count"`
+	Shards ShardMeta `json:"_shards"`
+	Error  *Error    `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [CountResponse] into an [opensearchtools.CountResponse].
+func (r CountResponse) toDomain() opensearchtools.CountResponse {
+	domainResp := opensearchtools.CountResponse{
+		Count:  r.Count,
+		Shards: r.Shards.toDomain(),
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
@@ -0,0 +1,59 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestCountRequest_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *CountRequest
+		want    string
+	}{
+		{
+			name:    "Match all",
+			request: NewCountRequest(),
+			want:    `{}`,
+		},
+		{
+			name:    "Term query",
+			request: NewCountRequest().WithQuery(opensearchtools.NewTermQuery("field", "value")),
+			want:    `{"query":{"term":{"field":"value"}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.request.ToOpenSearchJSON()
+			require.Nil(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestExecutor_Count(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"count": 42, "_shards": {"total": 2, "successful": 2, "skipped": 0, "failed": 0}}`)
+
+	req := opensearchtools.NewCountRequest().
+		AddIndices(testIndex1, testIndex2).
+		WithRouting("a", "b").
+		WithQuery(opensearchtools.NewTermQuery("field", "value"))
+
+	resp, err := executor.Count(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, "/test_index,test_index2/_count", recorded.Path)
+	require.Equal(t, "a,b", recorded.Query.Get("routing"))
+	require.JSONEq(t, `{"query":{"term":{"field":"value"}}}`, string(recorded.Body))
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, opensearchtools.CountResponse{
+		Count:  42,
+		Shards: opensearchtools.ShardMeta{Total: 2, Successful: 2},
+	}, resp.Response)
+}
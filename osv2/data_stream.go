@@ -0,0 +1,322 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// dataStreamPath is the path of the data stream APIs, which have no [opensearchapi] request type in opensearch-go v2
+const dataStreamPath = "_data_stream"
+
+// dataStreamPathSegments builds the path of a data stream API for the given data streams, all data streams if empty
+func dataStreamPathSegments(names []string, segments ...string) []string {
+	pathSegments := []string{dataStreamPath}
+	if len(names) > 0 {
+		pathSegments = append(pathSegments, strings.Join(names, ","))
+	}
+
+	return append(pathSegments, segments...)
+}
+
+// CreateDataStreamRequest is a serializable form of [opensearchtools.CreateDataStreamRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/data-streams/#step-2-create-a-data-stream
+type CreateDataStreamRequest struct {
+	// Name of the data stream to create
+	Name string
+}
+
+// FromDomainCreateDataStreamRequest creates a new [CreateDataStreamRequest] from the given [opensearchtools.CreateDataStreamRequest].
+func FromDomainCreateDataStreamRequest(req *opensearchtools.CreateDataStreamRequest) (CreateDataStreamRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return CreateDataStreamRequest{
+		Name: req.Name,
+	}, vrs
+}
+
+// Validate validates the given CreateDataStreamRequest
+func (r *CreateDataStreamRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Name == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Name not set on the CreateDataStreamRequest", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [CreateDataStreamRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *CreateDataStreamRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodPut, dataStreamPathSegments([]string{r.Name}), nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// DeleteDataStreamRequest is a serializable form of [opensearchtools.DeleteDataStreamRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/data-streams/#step-7-delete-a-data-stream
+type DeleteDataStreamRequest struct {
+	// Names of the data streams to delete, wildcards are supported
+	Names []string
+}
+
+// FromDomainDeleteDataStreamRequest creates a new [DeleteDataStreamRequest] from the given [opensearchtools.DeleteDataStreamRequest].
+func FromDomainDeleteDataStreamRequest(req *opensearchtools.DeleteDataStreamRequest) (DeleteDataStreamRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DeleteDataStreamRequest{
+		Names: req.Names,
+	}, vrs
+}
+
+// Validate validates the given DeleteDataStreamRequest
+func (r *DeleteDataStreamRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(r.Names) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("Names not set on the DeleteDataStreamRequest", true))
+	}
+
+	for _, name := range r.Names {
+		if name == "" {
+			validationResults.Add(opensearchtools.NewValidationResult("Empty name on the DeleteDataStreamRequest", true))
+		}
+	}
+
+	return validationResults
+}
+
+// Do executes the [DeleteDataStreamRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeleteDataStreamRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodDelete, dataStreamPathSegments(r.Names), nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// GetDataStreamsRequest is a serializable form of [opensearchtools.GetDataStreamsRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/data-streams/#step-4-manage-data-streams
+type GetDataStreamsRequest struct {
+	// Names of the data streams to get, wildcards are supported. All data streams if empty.
+	Names []string
+}
+
+// FromDomainGetDataStreamsRequest creates a new [GetDataStreamsRequest] from the given [opensearchtools.GetDataStreamsRequest].
+func FromDomainGetDataStreamsRequest(req *opensearchtools.GetDataStreamsRequest) (GetDataStreamsRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetDataStreamsRequest{
+		Names: req.Names,
+	}, vrs
+}
+
+// Do executes the [GetDataStreamsRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetDataStreamsResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetDataStreamsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetDataStreamsResponse], error) {
+	osResp, rErr := doRequest(ctx, client, http.MethodGet, dataStreamPathSegments(r.Names), nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	getResp, err := decodeResponse[GetDataStreamsResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		getResp,
+	)
+	return &resp, nil
+}
+
+// GetDataStreamsResponse represents the response for a [GetDataStreamsRequest].
+type GetDataStreamsResponse struct {
+	DataStreams []DataStream `json:"data_streams"`
+	Error       *Error       `json:"error,omitempty"`
+}
+
+// DataStream is a data stream in a [GetDataStreamsResponse].
+type DataStream struct {
+	Name           string `json:"name"`
+	TimestampField struct {
+		Name string `json:"name"`
+	} `json:"timestamp_field"`
+	Indices []struct {
+		IndexName string `json:"index_name"`
+		IndexUUID string `json:"index_uuid"`
+	} `json:"indices"`
+	Generation int    `json:"generation"`
+	Status     string `json:"status"`
+	Template   string `json:"template"`
+}
+
+// toDomain converts this instance of a [DataStream] into an [opensearchtools.DataStream].
+func (d DataStream) toDomain() opensearchtools.DataStream {
+	dataStream := opensearchtools.DataStream{
+		Name:           d.Name,
+		TimestampField: d.TimestampField.Name,
+		Indices:        make([]string, 0, len(d.Indices)),
+		Generation:     d.Generation,
+		Status:         d.Status,
+		Template:       d.Template,
+	}
+
+	for _, index := range d.Indices {
+		dataStream.Indices = append(dataStream.Indices, index.IndexName)
+	}
+
+	return dataStream
+}
+
+// toDomain converts this instance of a [GetDataStreamsResponse] into an [opensearchtools.GetDataStreamsResponse].
+func (r GetDataStreamsResponse) toDomain() opensearchtools.GetDataStreamsResponse {
+	var domainResp opensearchtools.GetDataStreamsResponse
+
+	for _, dataStream := range r.DataStreams {
+		domainResp.DataStreams = append(domainResp.DataStreams, dataStream.toDomain())
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// DataStreamStatsRequest is a serializable form of [opensearchtools.DataStreamStatsRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/data-streams/#step-4-manage-data-streams
+type DataStreamStatsRequest struct {
+	// Names of the data streams to get the stats of, wildcards are supported. All data streams if empty.
+	Names []string
+}
+
+// FromDomainDataStreamStatsRequest creates a new [DataStreamStatsRequest] from the given [opensearchtools.DataStreamStatsRequest].
+func FromDomainDataStreamStatsRequest(req *opensearchtools.DataStreamStatsRequest) (DataStreamStatsRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DataStreamStatsRequest{
+		Names: req.Names,
+	}, vrs
+}
+
+// Do executes the [DataStreamStatsRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [DataStreamStatsResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DataStreamStatsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[DataStreamStatsResponse], error) {
+	osResp, rErr := doRequest(ctx, client, http.MethodGet, dataStreamPathSegments(r.Names, "_stats"), nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	statsResp, err := decodeResponse[DataStreamStatsResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		statsResp,
+	)
+	return &resp, nil
+}
+
+// DataStreamStatsResponse represents the response for a [DataStreamStatsRequest].
+type DataStreamStatsResponse struct {
+	Shards              ShardMeta        `json:"_shards"`
+	DataStreamCount     int              `json:"data_stream_count"`
+	BackingIndices      int              `json:"backing_indices"`
+	TotalStoreSizeBytes int64            `json:"total_store_size_bytes"`
+	DataStreams         []DataStreamStat `json:"data_streams"`
+	Error               *Error           `json:"error,omitempty"`
+}
+
+// DataStreamStat are the stats of a data stream in a [DataStreamStatsResponse].
+type DataStreamStat struct {
+	DataStream       string `json:"data_stream"`
+	BackingIndices   int    `json:"backing_indices"`
+	StoreSizeBytes   int64  `json:"store_size_bytes"`
+	MaximumTimestamp int64  `json:"maximum_timestamp"`
+}
+
+// toDomain converts this instance of a [DataStreamStatsResponse] into an [opensearchtools.DataStreamStatsResponse].
+func (r DataStreamStatsResponse) toDomain() opensearchtools.DataStreamStatsResponse {
+	domainResp := opensearchtools.DataStreamStatsResponse{
+		Shards:              r.Shards.toDomain(),
+		DataStreamCount:     r.DataStreamCount,
+		BackingIndices:      r.BackingIndices,
+		TotalStoreSizeBytes: r.TotalStoreSizeBytes,
+	}
+
+	for _, stat := range r.DataStreams {
+		domainResp.DataStreams = append(domainResp.DataStreams, opensearchtools.DataStreamStat{
+			Name:             stat.DataStream,
+			BackingIndices:   stat.BackingIndices,
+			StoreSizeBytes:   stat.StoreSizeBytes,
+			MaximumTimestamp: time.UnixMilli(stat.MaximumTimestamp),
+		})
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
@@ -0,0 +1,114 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestExecutor_CreateDataStream(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	resp, err := executor.CreateDataStream(context.Background(), opensearchtools.NewCreateDataStreamRequest("logs-nginx"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, recorded.Method)
+	require.Equal(t, "/_data_stream/logs-nginx", recorded.Path)
+	require.True(t, resp.Response.Acknowledged)
+}
+
+func TestExecutor_CreateDataStreamWithoutName(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	_, err := executor.CreateDataStream(context.Background(), opensearchtools.NewCreateDataStreamRequest(""))
+	require.Error(t, err)
+}
+
+func TestExecutor_DeleteDataStream(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	resp, err := executor.DeleteDataStream(context.Background(), opensearchtools.NewDeleteDataStreamRequest("logs-nginx", "logs-apache"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, recorded.Method)
+	require.Equal(t, "/_data_stream/logs-nginx,logs-apache", recorded.Path)
+	require.True(t, resp.Response.Acknowledged)
+}
+
+func TestExecutor_GetDataStreams(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"data_streams": [{
+			"name": "logs-nginx",
+			"timestamp_field": {"name": "@timestamp"},
+			"indices": [
+				{"index_name": ".ds-logs-nginx-000001", "index_uuid": "Bnt7bm5eRlyM8qLaiKddrQ"},
+				{"index_name": ".ds-logs-nginx-000002", "index_uuid": "xFFRT6aMTzC7gV1vNYsrUg"}
+			],
+			"generation": 2,
+			"status": "GREEN",
+			"template": "logs-template"
+		}]
+	}`)
+
+	resp, err := executor.GetDataStreams(context.Background(), opensearchtools.NewGetDataStreamsRequest("logs-*"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_data_stream/logs-*", recorded.Path)
+	require.Equal(t, []opensearchtools.DataStream{{
+		Name:           "logs-nginx",
+		TimestampField: "@timestamp",
+		Indices:        []string{".ds-logs-nginx-000001", ".ds-logs-nginx-000002"},
+		Generation:     2,
+		Status:         "GREEN",
+		Template:       "logs-template",
+	}}, resp.Response.DataStreams)
+}
+
+func TestExecutor_GetDataStreamsMissing(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusNotFound, `{
+		"error": {"type": "index_not_found_exception", "reason": "no such index [logs-nginx]"},
+		"status": 404
+	}`)
+
+	resp, err := executor.GetDataStreams(context.Background(), opensearchtools.NewGetDataStreamsRequest())
+	require.Nil(t, err)
+	require.Equal(t, "/_data_stream", recorded.Path)
+	require.Empty(t, resp.Response.DataStreams)
+	require.Equal(t, "index_not_found_exception", resp.Response.Error.Type)
+}
+
+func TestExecutor_DataStreamStats(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"_shards": {"total": 2, "successful": 2, "failed": 0},
+		"data_stream_count": 1,
+		"backing_indices": 2,
+		"total_store_size_bytes": 4126,
+		"data_streams": [{
+			"data_stream": "logs-nginx",
+			"backing_indices": 2,
+			"store_size_bytes": 4126,
+			"maximum_timestamp": 1658146048666
+		}]
+	}`)
+
+	resp, err := executor.DataStreamStats(context.Background(), opensearchtools.NewDataStreamStatsRequest("logs-nginx"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_data_stream/logs-nginx/_stats", recorded.Path)
+
+	require.Equal(t, opensearchtools.DataStreamStatsResponse{
+		Shards:              opensearchtools.ShardMeta{Total: 2, Successful: 2},
+		DataStreamCount:     1,
+		BackingIndices:      2,
+		TotalStoreSizeBytes: 4126,
+		DataStreams: []opensearchtools.DataStreamStat{{
+			Name:             "logs-nginx",
+			BackingIndices:   2,
+			StoreSizeBytes:   4126,
+			MaximumTimestamp: time.UnixMilli(1658146048666),
+		}},
+	}, resp.Response)
+}
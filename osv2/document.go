@@ -0,0 +1,22 @@
+package osv2
+
+import (
+	"fmt"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// validateDocumentTarget ensures that a single document request targets both an index and an id.
+func validateDocumentTarget(requestName, index, id string) opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if index == "" {
+		validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Index not set on the %s", requestName), true))
+	}
+
+	if id == "" {
+		validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Doc ID not set on the %s", requestName), true))
+	}
+
+	return validationResults
+}
@@ -0,0 +1,132 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// DeleteRequest is a serializable form of [opensearchtools.DeleteRequest] specific to
+// the [opensearchapi.DeleteRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/delete-document/
+type DeleteRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to delete
+	ID string
+
+	// Refresh determines if the request should wait for a refresh or not
+	Refresh opensearchtools.Refresh
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+}
+
+// NewDeleteRequest instantiates a DeleteRequest for the document with the provided index and id.
+func NewDeleteRequest(index, id string) *DeleteRequest {
+	return &DeleteRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// FromDomainDeleteRequest creates a new [DeleteRequest] from the given [opensearchtools.DeleteRequest].
+func FromDomainDeleteRequest(req *opensearchtools.DeleteRequest) (DeleteRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DeleteRequest{
+		Index:   req.Index,
+		ID:      req.ID,
+		Refresh: req.Refresh,
+		Routing: req.Routing,
+	}, vrs
+}
+
+// Validate validates the given DeleteRequest
+func (r *DeleteRequest) Validate() opensearchtools.ValidationResults {
+	return validateDocumentTarget("DeleteRequest", r.Index, r.ID)
+}
+
+// Do executes the [DeleteRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [DeleteResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeleteRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[DeleteResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.DeleteRequest{
+		Index:      r.Index,
+		DocumentID: r.ID,
+		Refresh:    string(r.Refresh),
+		Routing:    r.Routing,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var respBuf bytes.Buffer
+	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
+		return nil, err
+	}
+
+	var deleteResp DeleteResponse
+	if err := json.Unmarshal(respBuf.Bytes(), &deleteResp); err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		deleteResp,
+	)
+	return &resp, nil
+}
+
+// DeleteResponse represents the response for a [DeleteRequest].
+type DeleteResponse struct {
+	Index       string    `json:"_index"`
+	ID          string    `json:"_id"`
+	Version     uint64    `json:"_version"`
+	Result      string    `json:"result"`
+	Shards      ShardMeta `json:"_shards"`
+	SeqNo       uint64    `json:"_seq_no"`
+	PrimaryTerm uint64    `json:"_primary_term"`
+	Error       *Error    `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [DeleteResponse] into an [opensearchtools.DeleteResponse].
+func (r DeleteResponse) toDomain() opensearchtools.DeleteResponse {
+	domainResp := opensearchtools.DeleteResponse{
+		Index:       r.Index,
+		ID:          r.ID,
+		Version:     r.Version,
+		Result:      r.Result,
+		Shards:      r.Shards.toDomain(),
+		SeqNo:       r.SeqNo,
+		PrimaryTerm: r.PrimaryTerm,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
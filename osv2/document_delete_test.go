@@ -0,0 +1,52 @@
+package osv2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestDeleteResponse_ToDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		respJSON string
+		want     opensearchtools.DeleteResponse
+	}{
+		{
+			name:     "Deleted document",
+			respJSON: `{"_index":"test_index","_id":"test_id","_version":2,"result":"deleted","_shards":{"total":2,"successful":2,"failed":0},"_seq_no":5,"_primary_term":1}`,
+			want: opensearchtools.DeleteResponse{
+				Index:       testIndex1,
+				ID:          testID1,
+				Version:     2,
+				Result:      "deleted",
+				Shards:      opensearchtools.ShardMeta{Total: 2, Successful: 2},
+				SeqNo:       5,
+				PrimaryTerm: 1,
+			},
+		},
+		{
+			name:     "Missing document",
+			respJSON: `{"_index":"test_index","_id":"test_id","_version":1,"result":"not_found","_shards":{"total":2,"successful":2,"failed":0},"_seq_no":6,"_primary_term":1}`,
+			want: opensearchtools.DeleteResponse{
+				Index:       testIndex1,
+				ID:          testID1,
+				Version:     1,
+				Result:      "not_found",
+				Shards:      opensearchtools.ShardMeta{Total: 2, Successful: 2},
+				SeqNo:       6,
+				PrimaryTerm: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp DeleteResponse
+			require.Nil(t, json.Unmarshal([]byte(tt.respJSON), &resp))
+			require.Equal(t, tt.want, resp.toDomain())
+		})
+	}
+}
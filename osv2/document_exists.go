@@ -0,0 +1,112 @@
+package osv2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// ExistsRequest is a serializable form of [opensearchtools.ExistsRequest] specific to
+// the [opensearchapi.ExistsRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/get-documents/
+type ExistsRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to check
+	ID string
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+}
+
+// NewExistsRequest instantiates an ExistsRequest for the document with the provided index and id.
+func NewExistsRequest(index, id string) *ExistsRequest {
+	return &ExistsRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// FromDomainExistsRequest creates a new [ExistsRequest] from the given [opensearchtools.ExistsRequest].
+func FromDomainExistsRequest(req *opensearchtools.ExistsRequest) (ExistsRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return ExistsRequest{
+		Index:   req.Index,
+		ID:      req.ID,
+		Routing: req.Routing,
+	}, vrs
+}
+
+// Validate validates the given ExistsRequest
+func (r *ExistsRequest) Validate() opensearchtools.ValidationResults {
+	return validateDocumentTarget("ExistsRequest", r.Index, r.ID)
+}
+
+// Do executes the [ExistsRequest] using the provided opensearch.Client.
+// OpenSearch responds with no body, the existence of the document is determined by the status code.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - OpenSearch responds with a status code other than 200 or 404
+func (r *ExistsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ExistsResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.ExistsRequest{
+		Index:      r.Index,
+		DocumentID: r.ID,
+		Routing:    r.Routing,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	// drain the body so the underlying connection can be reused
+	_, _ = io.Copy(io.Discard, osResp.Body)
+	_ = osResp.Body.Close()
+
+	var existsResp ExistsResponse
+	switch osResp.StatusCode {
+	case http.StatusOK:
+		existsResp.Exists = true
+	case http.StatusNotFound:
+		existsResp.Exists = false
+	default:
+		return nil, fmt.Errorf("unexpected status code %d checking existence of document %s in %s", osResp.StatusCode, r.ID, r.Index)
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		existsResp,
+	)
+	return &resp, nil
+}
+
+// ExistsResponse represents the response for an [ExistsRequest].
+type ExistsResponse struct {
+	Exists bool
+}
+
+// toDomain converts this instance of an [ExistsResponse] into an [opensearchtools.ExistsResponse].
+func (r ExistsResponse) toDomain() opensearchtools.ExistsResponse {
+	return opensearchtools.ExistsResponse{
+		Exists: r.Exists,
+	}
+}
@@ -0,0 +1,133 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// GetRequest is a serializable form of [opensearchtools.GetRequest] specific to
+// the [opensearchapi.GetRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/get-documents/
+type GetRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to fetch
+	ID string
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+}
+
+// NewGetRequest instantiates a GetRequest for the document with the provided index and id.
+func NewGetRequest(index, id string) *GetRequest {
+	return &GetRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// FromDomainGetRequest creates a new [GetRequest] from the given [opensearchtools.GetRequest].
+func FromDomainGetRequest(req *opensearchtools.GetRequest) (GetRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetRequest{
+		Index:   req.Index,
+		ID:      req.ID,
+		Routing: req.Routing,
+	}, vrs
+}
+
+// Validate validates the given GetRequest
+func (r *GetRequest) Validate() opensearchtools.ValidationResults {
+	return validateDocumentTarget("GetRequest", r.Index, r.ID)
+}
+
+// Do executes the [GetRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetResponse] will be returned.
+// A document that isn't found is not an error, [GetResponse.Found] will be false.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.GetRequest{
+		Index:      r.Index,
+		DocumentID: r.ID,
+		Routing:    r.Routing,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var respBuf bytes.Buffer
+	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
+		return nil, err
+	}
+
+	var getResp GetResponse
+	if err := json.Unmarshal(respBuf.Bytes(), &getResp); err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		getResp,
+	)
+	return &resp, nil
+}
+
+// GetResponse represents the response for a [GetRequest].
+type GetResponse struct {
+	Index       string          `json:"_index"`
+	ID          string          `json:"_id"`
+	Version     uint64          `json:"_version"`
+	SeqNo       uint64          `json:"_seq_no"`
+	PrimaryTerm uint64          `json:"_primary_term"`
+	Found       bool            `json:"found"`
+	Source      json.RawMessage `json:"_source,omitempty"`
+	Error       *Error          `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [GetResponse] into an [opensearchtools.GetResponse].
+func (r GetResponse) toDomain() opensearchtools.GetResponse {
+	domainResp := opensearchtools.GetResponse{
+		Index:       r.Index,
+		ID:          r.ID,
+		Version:     r.Version,
+		SeqNo:       r.SeqNo,
+		PrimaryTerm: r.PrimaryTerm,
+		Found:       r.Found,
+		Source:      r.Source,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// GetSource returns the raw bytes of the document of the GetResponse.
+func (r GetResponse) GetSource() []byte {
+	return []byte(r.Source)
+}
@@ -0,0 +1,58 @@
+package osv2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestGetResponse_ToDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		respJSON string
+		want     opensearchtools.GetResponse
+	}{
+		{
+			name:     "Found document",
+			respJSON: `{"_index":"test_index","_id":"test_id","_version":3,"_seq_no":7,"_primary_term":1,"found":true,"_source":{"name":"bob"}}`,
+			want: opensearchtools.GetResponse{
+				Index:       testIndex1,
+				ID:          testID1,
+				Version:     3,
+				SeqNo:       7,
+				PrimaryTerm: 1,
+				Found:       true,
+				Source:      json.RawMessage(`{"name":"bob"}`),
+			},
+		},
+		{
+			name:     "Missing document",
+			respJSON: `{"_index":"test_index","_id":"test_id","found":false}`,
+			want: opensearchtools.GetResponse{
+				Index: testIndex1,
+				ID:    testID1,
+			},
+		},
+		{
+			name:     "Missing index",
+			respJSON: `{"error":{"type":"index_not_found_exception","reason":"no such index","index":"test_index"},"status":404}`,
+			want: opensearchtools.GetResponse{
+				Error: &opensearchtools.Error{
+					Type:   "index_not_found_exception",
+					Reason: "no such index",
+					Index:  testIndex1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp GetResponse
+			require.Nil(t, json.Unmarshal([]byte(tt.respJSON), &resp))
+			require.Equal(t, tt.want, resp.toDomain())
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// IndexRequest is a serializable form of [opensearchtools.IndexRequest] specific to
+// the [opensearchapi.IndexRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/index-document/
+type IndexRequest struct {
+	// Doc to be indexed. The Doc is marshaled as the document source.
+	Doc opensearchtools.RoutableDoc
+
+	// Refresh determines if the request should wait for a refresh or not
+	Refresh opensearchtools.Refresh
+
+	// Routing value used to route the document to a specific shard
+	Routing string
+}
+
+// NewIndexRequest instantiates an IndexRequest for the provided doc.
+func NewIndexRequest(doc opensearchtools.RoutableDoc) *IndexRequest {
+	return &IndexRequest{Doc: doc}
+}
+
+// FromDomainIndexRequest creates a new [IndexRequest] from the given [opensearchtools.IndexRequest].
+func FromDomainIndexRequest(req *opensearchtools.IndexRequest) (IndexRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return IndexRequest{
+		Doc:     req.Doc,
+		Refresh: req.Refresh,
+		Routing: req.Routing,
+	}, vrs
+}
+
+// Validate validates the given IndexRequest
+func (r *IndexRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Doc == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("IndexRequest Doc is nil", true))
+		return validationResults
+	}
+
+	if r.Doc.Index() == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Index not set on the IndexRequest Doc", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [IndexRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [IndexResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The document cannot be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *IndexRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[IndexResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := json.Marshal(r.Doc)
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osResp, rErr := opensearchapi.IndexRequest{
+		Index:      r.Doc.Index(),
+		DocumentID: r.Doc.ID(),
+		Body:       bytes.NewReader(bodyBytes),
+		Refresh:    string(r.Refresh),
+		Routing:    r.Routing,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var respBuf bytes.Buffer
+	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
+		return nil, err
+	}
+
+	var indexResp IndexResponse
+	if err := json.Unmarshal(respBuf.Bytes(), &indexResp); err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		indexResp,
+	)
+	return &resp, nil
+}
+
+// IndexResponse represents the response for an [IndexRequest].
+type IndexResponse struct {
+	Index       string    `json:"_index"`
+	ID          string    `json:"_id"`
+	Version     uint64    `json:"_version"`
+	Result      string    `json:"result"`
+	Shards      ShardMeta `json:"_shards"`
+	SeqNo       uint64    `json:"_seq_no"`
+	PrimaryTerm uint64    `json:"_primary_term"`
+	Error       *Error    `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of an [IndexResponse] into an [opensearchtools.IndexResponse].
+func (r IndexResponse) toDomain() opensearchtools.IndexResponse {
+	domainResp := opensearchtools.IndexResponse{
+		Index:       r.Index,
+		ID:          r.ID,
+		Version:     r.Version,
+		Result:      r.Result,
+		Shards:      r.Shards.toDomain(),
+		SeqNo:       r.SeqNo,
+		PrimaryTerm: r.PrimaryTerm,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
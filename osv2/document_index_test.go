@@ -0,0 +1,84 @@
+package osv2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestIndexRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *IndexRequest
+		wantFatal bool
+	}{
+		{
+			name:      "Valid request",
+			request:   NewIndexRequest(opensearchtools.NewDocumentRef(testIndex1, testID1)),
+			wantFatal: false,
+		},
+		{
+			name:      "Missing ID is valid",
+			request:   NewIndexRequest(opensearchtools.NewDocumentRef(testIndex1, "")),
+			wantFatal: false,
+		},
+		{
+			name:      "Missing Index",
+			request:   NewIndexRequest(opensearchtools.NewDocumentRef("", testID1)),
+			wantFatal: true,
+		},
+		{
+			name:      "Nil Doc",
+			request:   NewIndexRequest(nil),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrs := tt.request.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestIndexResponse_ToDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		respJSON string
+		want     opensearchtools.IndexResponse
+	}{
+		{
+			name:     "Successful response",
+			respJSON: `{"_index":"test_index","_id":"test_id","_version":1,"result":"created","_shards":{"total":2,"successful":1,"failed":0},"_seq_no":4,"_primary_term":2}`,
+			want: opensearchtools.IndexResponse{
+				Index:       testIndex1,
+				ID:          testID1,
+				Version:     1,
+				Result:      "created",
+				Shards:      opensearchtools.ShardMeta{Total: 2, Successful: 1},
+				SeqNo:       4,
+				PrimaryTerm: 2,
+			},
+		},
+		{
+			name:     "Error response",
+			respJSON: `{"error":{"type":"mapper_parsing_exception","reason":"failed to parse"},"status":400}`,
+			want: opensearchtools.IndexResponse{
+				Error: &opensearchtools.Error{
+					Type:   "mapper_parsing_exception",
+					Reason: "failed to parse",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp IndexResponse
+			require.Nil(t, json.Unmarshal([]byte(tt.respJSON), &resp))
+			require.Equal(t, tt.want, resp.toDomain())
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package osv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateDocumentTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		index     string
+		id        string
+		wantLen   int
+		wantFatal bool
+	}{
+		{
+			name:      "Index and ID set",
+			index:     testIndex1,
+			id:        testID1,
+			wantLen:   0,
+			wantFatal: false,
+		},
+		{
+			name:      "Missing Index",
+			id:        testID1,
+			wantLen:   1,
+			wantFatal: true,
+		},
+		{
+			name:      "Missing ID",
+			index:     testIndex1,
+			wantLen:   1,
+			wantFatal: true,
+		},
+		{
+			name:      "Missing Index and ID",
+			wantLen:   2,
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrs := validateDocumentTarget("TestRequest", tt.index, tt.id)
+			require.Equal(t, tt.wantLen, vrs.Len())
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// UpdateRequest is a serializable form of [opensearchtools.UpdateRequest] specific to
+// the [opensearchapi.UpdateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/update-document/
+type UpdateRequest struct {
+	// Index the document is stored in
+	Index string
+
+	// ID of the document to update
+	ID string
+
+	// Body of the update
+	Body opensearchtools.UpdateBody
+
+	// Refresh determines if the request should wait for a refresh or not
+	Refresh opensearchtools.Refresh
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+
+	// RetryOnConflict is the number of times to retry the update if a version conflict occurs
+	RetryOnConflict int
+
+	// IfSeqNo only performs the update if the document has this sequence number
+	IfSeqNo uint64
+
+	// IfPrimaryTerm only performs the update if the document has this primary term
+	IfPrimaryTerm uint64
+}
+
+// NewUpdateRequest instantiates an UpdateRequest for the document with the provided index and id.
+func NewUpdateRequest(index, id string) *UpdateRequest {
+	return &UpdateRequest{
+		Index: index,
+		ID:    id,
+	}
+}
+
+// FromDomainUpdateRequest creates a new [UpdateRequest] from the given [opensearchtools.UpdateRequest].
+func FromDomainUpdateRequest(req *opensearchtools.UpdateRequest) (UpdateRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return UpdateRequest{
+		Index:           req.Index,
+		ID:              req.ID,
+		Body:            req.Body,
+		Refresh:         req.Refresh,
+		Routing:         req.Routing,
+		RetryOnConflict: req.RetryOnConflict,
+		IfSeqNo:         req.IfSeqNo,
+		IfPrimaryTerm:   req.IfPrimaryTerm,
+	}, vrs
+}
+
+// Validate validates the given UpdateRequest
+func (r *UpdateRequest) Validate() opensearchtools.ValidationResults {
+	validationResults := validateDocumentTarget("UpdateRequest", r.Index, r.ID)
+	validationResults.Extend(r.Body.Validate())
+
+	if r.RetryOnConflict > 0 && r.IfPrimaryTerm > 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("RetryOnConflict cannot be used with IfSeqNo and IfPrimaryTerm", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [UpdateRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [UpdateResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The update body cannot be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *UpdateRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[UpdateResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.Body.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.UpdateRequest{
+		Index:      r.Index,
+		DocumentID: r.ID,
+		Body:       bytes.NewReader(bodyBytes),
+		Refresh:    string(r.Refresh),
+		Routing:    r.Routing,
+	}
+
+	if r.RetryOnConflict > 0 {
+		retries := r.RetryOnConflict
+		osReq.RetryOnConflict = &retries
+	}
+
+	if r.IfPrimaryTerm > 0 {
+		seqNo, primaryTerm := int(r.IfSeqNo), int(r.IfPrimaryTerm)
+		osReq.IfSeqNo = &seqNo
+		osReq.IfPrimaryTerm = &primaryTerm
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var respBuf bytes.Buffer
+	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
+		return nil, err
+	}
+
+	var updateResp UpdateResponse
+	if err := json.Unmarshal(respBuf.Bytes(), &updateResp); err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		updateResp,
+	)
+	return &resp, nil
+}
+
+// UpdateResponse represents the response for an [UpdateRequest].
+type UpdateResponse struct {
+	Index       string        `json:"_index"`
+	ID          string        `json:"_id"`
+	Version     uint64        `json:"_version"`
+	Result      string        `json:"result"`
+	Shards      ShardMeta     `json:"_shards"`
+	SeqNo       uint64        `json:"_seq_no"`
+	PrimaryTerm uint64        `json:"_primary_term"`
+	Get         *UpdateResult `json:"get,omitempty"`
+	Error       *Error        `json:"error,omitempty"`
+}
+
+// UpdateResult contains the updated document when the source is requested on an [UpdateRequest].
+type UpdateResult struct {
+	Found  bool            `json:"found"`
+	Source json.RawMessage `json:"_source,omitempty"`
+}
+
+// toDomain converts this instance of an [UpdateResponse] into an [opensearchtools.UpdateResponse].
+func (r UpdateResponse) toDomain() opensearchtools.UpdateResponse {
+	domainResp := opensearchtools.UpdateResponse{
+		Index:       r.Index,
+		ID:          r.ID,
+		Version:     r.Version,
+		Result:      r.Result,
+		Shards:      r.Shards.toDomain(),
+		SeqNo:       r.SeqNo,
+		PrimaryTerm: r.PrimaryTerm,
+	}
+
+	if r.Get != nil {
+		domainResp.Source = r.Get.Source
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
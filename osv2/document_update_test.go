@@ -0,0 +1,112 @@
+package osv2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *UpdateRequest
+		wantFatal bool
+	}{
+		{
+			name: "Valid partial doc",
+			request: &UpdateRequest{
+				Index: testIndex1,
+				ID:    testID1,
+				Body:  opensearchtools.UpdateBody{Doc: map[string]any{"field": "value"}},
+			},
+			wantFatal: false,
+		},
+		{
+			name: "Missing ID",
+			request: &UpdateRequest{
+				Index: testIndex1,
+				Body:  opensearchtools.UpdateBody{Doc: map[string]any{"field": "value"}},
+			},
+			wantFatal: true,
+		},
+		{
+			name:      "Missing body",
+			request:   NewUpdateRequest(testIndex1, testID1),
+			wantFatal: true,
+		},
+		{
+			name: "Retry on conflict with optimistic concurrency",
+			request: &UpdateRequest{
+				Index:           testIndex1,
+				ID:              testID1,
+				Body:            opensearchtools.UpdateBody{Doc: map[string]any{"field": "value"}},
+				RetryOnConflict: 3,
+				IfSeqNo:         1,
+				IfPrimaryTerm:   1,
+			},
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrs := tt.request.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestUpdateResponse_ToDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		respJSON string
+		want     opensearchtools.UpdateResponse
+	}{
+		{
+			name:     "Updated without source",
+			respJSON: `{"_index":"test_index","_id":"test_id","_version":2,"result":"updated","_shards":{"total":2,"successful":2,"failed":0},"_seq_no":3,"_primary_term":1}`,
+			want: opensearchtools.UpdateResponse{
+				Index:       testIndex1,
+				ID:          testID1,
+				Version:     2,
+				Result:      "updated",
+				Shards:      opensearchtools.ShardMeta{Total: 2, Successful: 2},
+				SeqNo:       3,
+				PrimaryTerm: 1,
+			},
+		},
+		{
+			name:     "Updated with source",
+			respJSON: `{"_index":"test_index","_id":"test_id","_version":2,"result":"updated","_seq_no":3,"_primary_term":1,"get":{"found":true,"_source":{"count":2}}}`,
+			want: opensearchtools.UpdateResponse{
+				Index:       testIndex1,
+				ID:          testID1,
+				Version:     2,
+				Result:      "updated",
+				SeqNo:       3,
+				PrimaryTerm: 1,
+				Source:      json.RawMessage(`{"count":2}`),
+			},
+		},
+		{
+			name:     "Version conflict",
+			respJSON: `{"error":{"type":"version_conflict_engine_exception","reason":"version conflict","index":"test_index"},"status":409}`,
+			want: opensearchtools.UpdateResponse{
+				Error: &opensearchtools.Error{
+					Type:   "version_conflict_engine_exception",
+					Reason: "version conflict",
+					Index:  testIndex1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp UpdateResponse
+			require.Nil(t, json.Unmarshal([]byte(tt.respJSON), &resp))
+			require.Equal(t, tt.want, resp.toDomain())
+		})
+	}
+}
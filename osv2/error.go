@@ -13,6 +13,12 @@ type Error struct {
 	IndexUUID    string  `json:"index_uuid"`
 }
 
+// errorResponse is the body of a failed request whose successful response can't hold an error,
+// such as responses keyed by index name
+type errorResponse struct {
+	Error *Error `json:"error"`
+}
+
 // toDomain converts this instance of an Error into an [opensearchtools.Error]
 func (e *Error) toDomain() opensearchtools.Error {
 	var modelRootCauses []opensearchtools.Error
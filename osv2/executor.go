@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 
 	"github.com/CrowdStrike/opensearchtools"
 )
@@ -14,11 +15,40 @@ type Executor struct {
 	Client *opensearch.Client
 }
 
+// ExecutorOption configures an [Executor] when it is created with [NewExecutor].
+type ExecutorOption func(e *Executor)
+
+// WithGzipCompression gzips request bodies of at least threshold bytes, setting the Content-Encoding header,
+// and accepts gzip compressed responses. A threshold of zero compresses every request body.
+// Bodies of unknown length, such as those of streaming bulk requests, are always compressed.
+// Bodies which already have a Content-Encoding are left as they are.
+// The Metrics and DiscoverNodes methods of the given client remain available on the Executor's Client.
+//
+// If the given client is built with [opensearch.Config] CompressRequestBody, the client compresses every
+// request body itself, so the threshold is ignored and this option only accepts compressed responses.
+func WithGzipCompression(threshold int) ExecutorOption {
+	return func(e *Executor) {
+		// a new client wraps the transport so compression doesn't affect other users of the given client
+		transport := newGzipTransport(e.Client.Transport, threshold)
+
+		e.Client = &opensearch.Client{
+			Transport: transport,
+			API:       opensearchapi.New(transport),
+		}
+	}
+}
+
 // NewExecutor creates a new [osv2.Executor] instance.
-func NewExecutor(client *opensearch.Client) *Executor {
-	return &Executor{
+func NewExecutor(client *opensearch.Client, opts ...ExecutorOption) *Executor {
+	e := &Executor{
 		Client: client,
 	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
 }
 
 // MGet executes the Multi-Get MGetRequest using the provided [opensearchtools.MGetRequest].
@@ -100,3 +130,1258 @@ func (e *Executor) Bulk(ctx context.Context, req *opensearchtools.BulkRequest) (
 
 	return resp, nil
 }
+
+// Index executes the IndexRequest using the provided [opensearchtools.IndexRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing an [opensearchtools.IndexResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Index(ctx context.Context, req *opensearchtools.IndexRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.IndexResponse], err error) {
+	osv2Req, vrs := FromDomainIndexRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Get executes the GetRequest using the provided [opensearchtools.GetRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing a [opensearchtools.GetResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Get(ctx context.Context, req *opensearchtools.GetRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetResponse], err error) {
+	osv2Req, vrs := FromDomainGetRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Delete executes the DeleteRequest using the provided [opensearchtools.DeleteRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing a [opensearchtools.DeleteResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Delete(ctx context.Context, req *opensearchtools.DeleteRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.DeleteResponse], err error) {
+	osv2Req, vrs := FromDomainDeleteRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Exists executes the ExistsRequest using the provided [opensearchtools.ExistsRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing an [opensearchtools.ExistsResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - OpenSearch responds with an unexpected status code
+func (e *Executor) Exists(ctx context.Context, req *opensearchtools.ExistsRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ExistsResponse], err error) {
+	osv2Req, vrs := FromDomainExistsRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Update executes the UpdateRequest using the provided [opensearchtools.UpdateRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing an [opensearchtools.UpdateResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Update(ctx context.Context, req *opensearchtools.UpdateRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.UpdateResponse], err error) {
+	osv2Req, vrs := FromDomainUpdateRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// CreatePIT executes the CreatePITRequest using the provided [opensearchtools.CreatePITRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing a [opensearchtools.CreatePITResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) CreatePIT(ctx context.Context, req *opensearchtools.CreatePITRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.CreatePITResponse], err error) {
+	osv2Req, vrs := FromDomainCreatePITRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DeletePIT executes the DeletePITRequest using the provided [opensearchtools.DeletePITRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing a [opensearchtools.DeletePITResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DeletePIT(ctx context.Context, req *opensearchtools.DeletePITRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.DeletePITResponse], err error) {
+	osv2Req, vrs := FromDomainDeletePITRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// ListPITs lists all the point-in-times of the cluster.
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing a [opensearchtools.ListPITsResponse] will be returned.
+// An error can be returned if:
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) ListPITs(ctx context.Context) (resp opensearchtools.OpenSearchResponse[opensearchtools.ListPITsResponse], err error) {
+	osv2Resp, reqErr := (&ListPITsRequest{}).Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Scroll executes the ScrollRequest using the provided [opensearchtools.ScrollRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing a [opensearchtools.SearchResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Scroll(ctx context.Context, req *opensearchtools.ScrollRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.SearchResponse], err error) {
+	osv2Req, vrs := FromDomainScrollRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// ClearScroll executes the ClearScrollRequest using the provided [opensearchtools.ClearScrollRequest].
+// If the request is executed successfully, then an
+// [opensearchtools.OpenSearchResponse] containing a [opensearchtools.ClearScrollResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) ClearScroll(ctx context.Context, req *opensearchtools.ClearScrollRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ClearScrollResponse], err error) {
+	osv2Req, vrs := FromDomainClearScrollRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// MSearch executes the MSearchRequest using the provided [opensearchtools.MSearchRequest].
+// If the request is executed successfully, then an [opensearchtools.MSearchResponse] with an
+// [opensearchtools.MSearchResult] for each search will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) MSearch(ctx context.Context, req *opensearchtools.MSearchRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.MSearchResponse], err error) {
+	osv2Req, vrs := FromDomainMSearchRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Count executes the CountRequest using the provided [opensearchtools.CountRequest].
+// If the request is executed successfully, then an [opensearchtools.CountResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Count(ctx context.Context, req *opensearchtools.CountRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.CountResponse], err error) {
+	osv2Req, vrs := FromDomainCountRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DeleteByQuery executes the DeleteByQueryRequest using the provided [opensearchtools.DeleteByQueryRequest].
+// When not waiting for completion, the response only contains the task ID.
+// If the request is executed successfully, then an [opensearchtools.BulkByScrollResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DeleteByQuery(ctx context.Context, req *opensearchtools.DeleteByQueryRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.BulkByScrollResponse], err error) {
+	osv2Req, vrs := FromDomainDeleteByQueryRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// UpdateByQuery executes the UpdateByQueryRequest using the provided [opensearchtools.UpdateByQueryRequest].
+// When not waiting for completion, the response only contains the task ID.
+// If the request is executed successfully, then an [opensearchtools.BulkByScrollResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) UpdateByQuery(ctx context.Context, req *opensearchtools.UpdateByQueryRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.BulkByScrollResponse], err error) {
+	osv2Req, vrs := FromDomainUpdateByQueryRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetTask executes the GetTaskRequest using the provided [opensearchtools.GetTaskRequest].
+// If the request is executed successfully, then a [opensearchtools.GetTaskResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetTask(ctx context.Context, req *opensearchtools.GetTaskRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetTaskResponse], err error) {
+	osv2Req, vrs := FromDomainGetTaskRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Reindex executes the ReindexRequest using the provided [opensearchtools.ReindexRequest].
+// When not waiting for completion, the response only contains the task ID.
+// If the request is executed successfully, then an [opensearchtools.BulkByScrollResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Reindex(ctx context.Context, req *opensearchtools.ReindexRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.BulkByScrollResponse], err error) {
+	osv2Req, vrs := FromDomainReindexRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// ListTasks executes the ListTasksRequest using the provided [opensearchtools.ListTasksRequest].
+// If the request is executed successfully, then an [opensearchtools.ListTasksResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) ListTasks(ctx context.Context, req *opensearchtools.ListTasksRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ListTasksResponse], err error) {
+	osv2Req, vrs := FromDomainListTasksRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// CancelTask executes the CancelTaskRequest using the provided [opensearchtools.CancelTaskRequest].
+// If the request is executed successfully, then an [opensearchtools.CancelTaskResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) CancelTask(ctx context.Context, req *opensearchtools.CancelTaskRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.CancelTaskResponse], err error) {
+	osv2Req, vrs := FromDomainCancelTaskRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// CreateIndex executes the CreateIndexRequest using the provided [opensearchtools.CreateIndexRequest].
+// If the request is executed successfully, then a [opensearchtools.CreateIndexResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) CreateIndex(ctx context.Context, req *opensearchtools.CreateIndexRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.CreateIndexResponse], err error) {
+	osv2Req, vrs := FromDomainCreateIndexRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DeleteIndex executes the DeleteIndexRequest using the provided [opensearchtools.DeleteIndexRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DeleteIndex(ctx context.Context, req *opensearchtools.DeleteIndexRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainDeleteIndexRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// IndexExists executes the IndexExistsRequest using the provided [opensearchtools.IndexExistsRequest].
+// If the request is executed successfully, then an [opensearchtools.IndexExistsResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) IndexExists(ctx context.Context, req *opensearchtools.IndexExistsRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.IndexExistsResponse], err error) {
+	osv2Req, vrs := FromDomainIndexExistsRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// OpenIndex executes the OpenIndexRequest using the provided [opensearchtools.OpenIndexRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) OpenIndex(ctx context.Context, req *opensearchtools.OpenIndexRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainOpenIndexRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// CloseIndex executes the CloseIndexRequest using the provided [opensearchtools.CloseIndexRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) CloseIndex(ctx context.Context, req *opensearchtools.CloseIndexRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainCloseIndexRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetMapping executes the GetMappingRequest using the provided [opensearchtools.GetMappingRequest].
+// If the request is executed successfully, then a [opensearchtools.GetMappingResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetMapping(ctx context.Context, req *opensearchtools.GetMappingRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetMappingResponse], err error) {
+	osv2Req, vrs := FromDomainGetMappingRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// PutMapping executes the PutMappingRequest using the provided [opensearchtools.PutMappingRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) PutMapping(ctx context.Context, req *opensearchtools.PutMappingRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainPutMappingRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetSettings executes the GetSettingsRequest using the provided [opensearchtools.GetSettingsRequest].
+// If the request is executed successfully, then a [opensearchtools.GetSettingsResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetSettings(ctx context.Context, req *opensearchtools.GetSettingsRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetSettingsResponse], err error) {
+	osv2Req, vrs := FromDomainGetSettingsRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// UpdateSettings executes the UpdateSettingsRequest using the provided [opensearchtools.UpdateSettingsRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) UpdateSettings(ctx context.Context, req *opensearchtools.UpdateSettingsRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainUpdateSettingsRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// AliasActions executes the AliasActionsRequest using the provided [opensearchtools.AliasActionsRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) AliasActions(ctx context.Context, req *opensearchtools.AliasActionsRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainAliasActionsRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetAliases executes the GetAliasesRequest using the provided [opensearchtools.GetAliasesRequest].
+// If the request is executed successfully, then a [opensearchtools.GetAliasesResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetAliases(ctx context.Context, req *opensearchtools.GetAliasesRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetAliasesResponse], err error) {
+	osv2Req, vrs := FromDomainGetAliasesRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// PutComponentTemplate executes the PutComponentTemplateRequest using the provided [opensearchtools.PutComponentTemplateRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) PutComponentTemplate(ctx context.Context, req *opensearchtools.PutComponentTemplateRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainPutComponentTemplateRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetComponentTemplates executes the GetComponentTemplatesRequest using the provided [opensearchtools.GetComponentTemplatesRequest].
+// If the request is executed successfully, then a [opensearchtools.GetComponentTemplatesResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetComponentTemplates(ctx context.Context, req *opensearchtools.GetComponentTemplatesRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetComponentTemplatesResponse], err error) {
+	osv2Req, vrs := FromDomainGetComponentTemplatesRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DeleteComponentTemplate executes the DeleteComponentTemplateRequest using the provided [opensearchtools.DeleteComponentTemplateRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DeleteComponentTemplate(ctx context.Context, req *opensearchtools.DeleteComponentTemplateRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainDeleteComponentTemplateRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// PutIndexTemplate executes the PutIndexTemplateRequest using the provided [opensearchtools.PutIndexTemplateRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) PutIndexTemplate(ctx context.Context, req *opensearchtools.PutIndexTemplateRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainPutIndexTemplateRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetIndexTemplates executes the GetIndexTemplatesRequest using the provided [opensearchtools.GetIndexTemplatesRequest].
+// If the request is executed successfully, then a [opensearchtools.GetIndexTemplatesResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetIndexTemplates(ctx context.Context, req *opensearchtools.GetIndexTemplatesRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetIndexTemplatesResponse], err error) {
+	osv2Req, vrs := FromDomainGetIndexTemplatesRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DeleteIndexTemplate executes the DeleteIndexTemplateRequest using the provided [opensearchtools.DeleteIndexTemplateRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DeleteIndexTemplate(ctx context.Context, req *opensearchtools.DeleteIndexTemplateRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainDeleteIndexTemplateRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// SimulateIndexTemplate executes the SimulateIndexTemplateRequest using the provided [opensearchtools.SimulateIndexTemplateRequest].
+// If the request is executed successfully, then a [opensearchtools.SimulateIndexTemplateResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) SimulateIndexTemplate(ctx context.Context, req *opensearchtools.SimulateIndexTemplateRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.SimulateIndexTemplateResponse], err error) {
+	osv2Req, vrs := FromDomainSimulateIndexTemplateRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// PutISMPolicy executes the PutISMPolicyRequest using the provided [opensearchtools.PutISMPolicyRequest].
+// If the request is executed successfully, then an [opensearchtools.ISMPolicyResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) PutISMPolicy(ctx context.Context, req *opensearchtools.PutISMPolicyRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ISMPolicyResponse], err error) {
+	osv2Req, vrs := FromDomainPutISMPolicyRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetISMPolicy executes the GetISMPolicyRequest using the provided [opensearchtools.GetISMPolicyRequest].
+// If the request is executed successfully, then an [opensearchtools.ISMPolicyResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetISMPolicy(ctx context.Context, req *opensearchtools.GetISMPolicyRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ISMPolicyResponse], err error) {
+	osv2Req, vrs := FromDomainGetISMPolicyRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DeleteISMPolicy executes the DeleteISMPolicyRequest using the provided [opensearchtools.DeleteISMPolicyRequest].
+// If the request is executed successfully, then a [opensearchtools.DeleteISMPolicyResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DeleteISMPolicy(ctx context.Context, req *opensearchtools.DeleteISMPolicyRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.DeleteISMPolicyResponse], err error) {
+	osv2Req, vrs := FromDomainDeleteISMPolicyRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// AddISMPolicy executes the AddISMPolicyRequest using the provided [opensearchtools.AddISMPolicyRequest].
+// If the request is executed successfully, then an [opensearchtools.ISMIndicesResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) AddISMPolicy(ctx context.Context, req *opensearchtools.AddISMPolicyRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ISMIndicesResponse], err error) {
+	osv2Req, vrs := FromDomainAddISMPolicyRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// RemoveISMPolicy executes the RemoveISMPolicyRequest using the provided [opensearchtools.RemoveISMPolicyRequest].
+// If the request is executed successfully, then an [opensearchtools.ISMIndicesResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) RemoveISMPolicy(ctx context.Context, req *opensearchtools.RemoveISMPolicyRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ISMIndicesResponse], err error) {
+	osv2Req, vrs := FromDomainRemoveISMPolicyRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// ChangeISMPolicy executes the ChangeISMPolicyRequest using the provided [opensearchtools.ChangeISMPolicyRequest].
+// If the request is executed successfully, then an [opensearchtools.ISMIndicesResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) ChangeISMPolicy(ctx context.Context, req *opensearchtools.ChangeISMPolicyRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ISMIndicesResponse], err error) {
+	osv2Req, vrs := FromDomainChangeISMPolicyRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// ExplainISM executes the ExplainISMRequest using the provided [opensearchtools.ExplainISMRequest].
+// If the request is executed successfully, then an [opensearchtools.ExplainISMResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) ExplainISM(ctx context.Context, req *opensearchtools.ExplainISMRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.ExplainISMResponse], err error) {
+	osv2Req, vrs := FromDomainExplainISMRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// Rollover executes the RolloverRequest using the provided [opensearchtools.RolloverRequest].
+// If the request is executed successfully, then a [opensearchtools.RolloverResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) Rollover(ctx context.Context, req *opensearchtools.RolloverRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.RolloverResponse], err error) {
+	osv2Req, vrs := FromDomainRolloverRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// CreateDataStream executes the CreateDataStreamRequest using the provided [opensearchtools.CreateDataStreamRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) CreateDataStream(ctx context.Context, req *opensearchtools.CreateDataStreamRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainCreateDataStreamRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DeleteDataStream executes the DeleteDataStreamRequest using the provided [opensearchtools.DeleteDataStreamRequest].
+// If the request is executed successfully, then an [opensearchtools.AcknowledgedResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DeleteDataStream(ctx context.Context, req *opensearchtools.DeleteDataStreamRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.AcknowledgedResponse], err error) {
+	osv2Req, vrs := FromDomainDeleteDataStreamRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// GetDataStreams executes the GetDataStreamsRequest using the provided [opensearchtools.GetDataStreamsRequest].
+// If the request is executed successfully, then a [opensearchtools.GetDataStreamsResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) GetDataStreams(ctx context.Context, req *opensearchtools.GetDataStreamsRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.GetDataStreamsResponse], err error) {
+	osv2Req, vrs := FromDomainGetDataStreamsRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
+
+// DataStreamStats executes the DataStreamStatsRequest using the provided [opensearchtools.DataStreamStatsRequest].
+// If the request is executed successfully, then a [opensearchtools.DataStreamStatsResponse] will be returned.
+// An error can be returned if:
+//   - Fatal validation issues are found
+//   - The request to OpenSearch fails
+//   - The results json cannot be unmarshalled
+func (e *Executor) DataStreamStats(ctx context.Context, req *opensearchtools.DataStreamStatsRequest) (resp opensearchtools.OpenSearchResponse[opensearchtools.DataStreamStatsResponse], err error) {
+	osv2Req, vrs := FromDomainDataStreamStatsRequest(req)
+	resp.ValidationResults.Extend(vrs)
+	if vrs.IsFatal() {
+		return resp, opensearchtools.NewValidationError(vrs)
+	}
+
+	osv2Resp, reqErr := osv2Req.Do(ctx, e.Client)
+	if reqErr != nil {
+		return resp, reqErr
+	}
+
+	resp.ValidationResults.Extend(osv2Resp.ValidationResults)
+	resp.Response = osv2Resp.Response.toDomain()
+	resp.StatusCode = osv2Resp.StatusCode
+	resp.Header = osv2Resp.Header
+
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package osv2
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest is a request received by the test server of newRecordingExecutor.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
+}
+
+// newRecordingExecutor creates an Executor whose requests are recorded by a test server
+// responding to every request with the status and JSON body.
+func newRecordingExecutor(t *testing.T, status int, body string) (*Executor, *recordedRequest) {
+	t.Helper()
+
+	recorded := &recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqBody, _ := io.ReadAll(r.Body)
+		*recorded = recordedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.Query(),
+			Body:   reqBody,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := opensearch.NewClient(opensearch.Config{Addresses: []string{server.URL}})
+	require.Nil(t, err)
+
+	return NewExecutor(client), recorded
+}
@@ -0,0 +1,521 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// CreateIndexRequest is a serializable form of [opensearchtools.CreateIndexRequest] specific to
+// the [opensearchapi.IndicesCreateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/create-index/
+type CreateIndexRequest struct {
+	// Index to create
+	Index string
+
+	// Settings of the index
+	Settings *IndexSettings
+
+	// Mapping of the index
+	Mapping *Mapping
+
+	// RawSettings of the index in the JSON shape expected by OpenSearch, replacing Settings
+	RawSettings map[string]any
+
+	// Mappings of the index in the JSON shape expected by OpenSearch, replacing Mapping
+	Mappings map[string]any
+
+	// Aliases of the index
+	Aliases []opensearchtools.Alias
+}
+
+// FromDomainCreateIndexRequest creates a new [CreateIndexRequest] from the given [opensearchtools.CreateIndexRequest].
+// The alias filters are converted with [V2QueryConverter].
+func FromDomainCreateIndexRequest(req *opensearchtools.CreateIndexRequest) (CreateIndexRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	createRequest := CreateIndexRequest{
+		Index:       req.Index,
+		Settings:    FromDomainIndexSettings(req.Settings),
+		Mapping:     FromDomainMapping(req.Mapping),
+		RawSettings: req.RawSettings,
+		Mappings:    req.Mappings,
+	}
+
+	aliases, cErr := fromDomainAliases(req.Aliases)
+	if cErr != nil {
+		vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+		return createRequest, vrs
+	}
+
+	createRequest.Aliases = aliases
+
+	return createRequest, vrs
+}
+
+// Validate validates the given CreateIndexRequest
+func (r *CreateIndexRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Index == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Index not set on the CreateIndexRequest", true))
+	}
+
+	if r.Settings != nil && r.RawSettings != nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Settings and RawSettings both set on the CreateIndexRequest", true))
+	}
+
+	if r.Mapping != nil && r.Mappings != nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Mapping and Mappings both set on the CreateIndexRequest", true))
+	}
+
+	for _, alias := range r.Aliases {
+		if alias.Name == "" {
+			validationResults.Add(opensearchtools.NewValidationResult("Alias name not set on the CreateIndexRequest", true))
+		}
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the CreateIndexRequest into the JSON shape expected by OpenSearch.
+// The RawSettings and Mappings replace the Settings and Mapping if set.
+func (r *CreateIndexRequest) ToOpenSearchJSON() ([]byte, error) {
+	bodyBytes, err := json.Marshal(&Template{
+		Settings: r.Settings,
+		Mapping:  r.Mapping,
+		Aliases:  r.Aliases,
+	})
+	if err != nil || (r.RawSettings == nil && r.Mappings == nil) {
+		return bodyBytes, err
+	}
+
+	var source map[string]json.RawMessage
+	if err := json.Unmarshal(bodyBytes, &source); err != nil {
+		return nil, err
+	}
+
+	raw := map[string]map[string]any{"settings": r.RawSettings, "mappings": r.Mappings}
+	for key, value := range raw {
+		if value == nil {
+			continue
+		}
+
+		valueBytes, jErr := json.Marshal(value)
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		source[key] = valueBytes
+	}
+
+	return json.Marshal(source)
+}
+
+// Do executes the [CreateIndexRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [CreateIndexResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *CreateIndexRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[CreateIndexResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osResp, rErr := opensearchapi.IndicesCreateRequest{
+		Index: r.Index,
+		Body:  bytes.NewReader(bodyBytes),
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	createResp, err := decodeResponse[CreateIndexResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		createResp,
+	)
+	return &resp, nil
+}
+
+// CreateIndexResponse represents the response for a [CreateIndexRequest].
+type CreateIndexResponse struct {
+	Acknowledged       bool   `json:"acknowledged"`
+	ShardsAcknowledged bool   `json:"shards_acknowledged"`
+	Index              string `json:"index"`
+	Error              *Error `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [CreateIndexResponse] into an [opensearchtools.CreateIndexResponse].
+func (r CreateIndexResponse) toDomain() opensearchtools.CreateIndexResponse {
+	domainResp := opensearchtools.CreateIndexResponse{
+		Acknowledged:       r.Acknowledged,
+		ShardsAcknowledged: r.ShardsAcknowledged,
+		Index:              r.Index,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// DeleteIndexRequest is a serializable form of [opensearchtools.DeleteIndexRequest] specific to
+// the [opensearchapi.IndicesDeleteRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/delete-index/
+type DeleteIndexRequest struct {
+	// Indices to delete, wildcards are supported
+	Indices []string
+
+	// IgnoreUnavailable ignores missing or closed indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, the request fails if a wildcard resolves to no index. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// FromDomainDeleteIndexRequest creates a new [DeleteIndexRequest] from the given [opensearchtools.DeleteIndexRequest].
+func FromDomainDeleteIndexRequest(req *opensearchtools.DeleteIndexRequest) (DeleteIndexRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DeleteIndexRequest{
+		Indices:           req.Indices,
+		IgnoreUnavailable: req.IgnoreUnavailable,
+		AllowNoIndices:    req.AllowNoIndices,
+	}, vrs
+}
+
+// Validate validates the given DeleteIndexRequest
+func (r *DeleteIndexRequest) Validate() opensearchtools.ValidationResults {
+	return validateIndices("DeleteIndexRequest", r.Indices)
+}
+
+// Do executes the [DeleteIndexRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeleteIndexRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.IndicesDeleteRequest{
+		Index:             r.Indices,
+		IgnoreUnavailable: ignoreUnavailableParam(r.IgnoreUnavailable),
+		AllowNoIndices:    r.AllowNoIndices,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// IndexExistsRequest is a serializable form of [opensearchtools.IndexExistsRequest] specific to
+// the [opensearchapi.IndicesExistsRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/exists/
+type IndexExistsRequest struct {
+	// Indices to check, all of them must exist. Wildcards are supported.
+	Indices []string
+
+	// IgnoreUnavailable ignores missing or closed indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, a wildcard resolving to no index doesn't exist. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// FromDomainIndexExistsRequest creates a new [IndexExistsRequest] from the given [opensearchtools.IndexExistsRequest].
+func FromDomainIndexExistsRequest(req *opensearchtools.IndexExistsRequest) (IndexExistsRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return IndexExistsRequest{
+		Indices:           req.Indices,
+		IgnoreUnavailable: req.IgnoreUnavailable,
+		AllowNoIndices:    req.AllowNoIndices,
+	}, vrs
+}
+
+// Validate validates the given IndexExistsRequest
+func (r *IndexExistsRequest) Validate() opensearchtools.ValidationResults {
+	return validateIndices("IndexExistsRequest", r.Indices)
+}
+
+// Do executes the [IndexExistsRequest] using the provided opensearch.Client.
+// OpenSearch responds with no body, the existence of the indices is determined by the status code.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - OpenSearch responds with a status code other than 200 or 404
+func (r *IndexExistsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[IndexExistsResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.IndicesExistsRequest{
+		Index:             r.Indices,
+		IgnoreUnavailable: ignoreUnavailableParam(r.IgnoreUnavailable),
+		AllowNoIndices:    r.AllowNoIndices,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	// drain the body so the underlying connection can be reused
+	_, _ = io.Copy(io.Discard, osResp.Body)
+	_ = osResp.Body.Close()
+
+	var existsResp IndexExistsResponse
+	switch osResp.StatusCode {
+	case http.StatusOK:
+		existsResp.Exists = true
+	case http.StatusNotFound:
+		existsResp.Exists = false
+	default:
+		return nil, fmt.Errorf("unexpected status code %d checking existence of indices %s", osResp.StatusCode, strings.Join(r.Indices, ","))
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		existsResp,
+	)
+	return &resp, nil
+}
+
+// IndexExistsResponse represents the response for an [IndexExistsRequest].
+type IndexExistsResponse struct {
+	Exists bool
+}
+
+// toDomain converts this instance of an [IndexExistsResponse] into an [opensearchtools.IndexExistsResponse].
+func (r IndexExistsResponse) toDomain() opensearchtools.IndexExistsResponse {
+	return opensearchtools.IndexExistsResponse{
+		Exists: r.Exists,
+	}
+}
+
+// OpenIndexRequest is a serializable form of [opensearchtools.OpenIndexRequest] specific to
+// the [opensearchapi.IndicesOpenRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/open-index/
+type OpenIndexRequest struct {
+	// Indices to open, wildcards are supported
+	Indices []string
+
+	// IgnoreUnavailable ignores missing indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, the request fails if a wildcard resolves to no index. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// FromDomainOpenIndexRequest creates a new [OpenIndexRequest] from the given [opensearchtools.OpenIndexRequest].
+func FromDomainOpenIndexRequest(req *opensearchtools.OpenIndexRequest) (OpenIndexRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return OpenIndexRequest{
+		Indices:           req.Indices,
+		IgnoreUnavailable: req.IgnoreUnavailable,
+		AllowNoIndices:    req.AllowNoIndices,
+	}, vrs
+}
+
+// Validate validates the given OpenIndexRequest
+func (r *OpenIndexRequest) Validate() opensearchtools.ValidationResults {
+	return validateIndices("OpenIndexRequest", r.Indices)
+}
+
+// Do executes the [OpenIndexRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *OpenIndexRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.IndicesOpenRequest{
+		Index:             r.Indices,
+		IgnoreUnavailable: ignoreUnavailableParam(r.IgnoreUnavailable),
+		AllowNoIndices:    r.AllowNoIndices,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// CloseIndexRequest is a serializable form of [opensearchtools.CloseIndexRequest] specific to
+// the [opensearchapi.IndicesCloseRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/close-index/
+type CloseIndexRequest struct {
+	// Indices to close, wildcards are supported
+	Indices []string
+
+	// IgnoreUnavailable ignores missing indices
+	IgnoreUnavailable bool
+
+	// AllowNoIndices - when false, the request fails if a wildcard resolves to no index. Defaults to true if nil.
+	AllowNoIndices *bool
+}
+
+// FromDomainCloseIndexRequest creates a new [CloseIndexRequest] from the given [opensearchtools.CloseIndexRequest].
+func FromDomainCloseIndexRequest(req *opensearchtools.CloseIndexRequest) (CloseIndexRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return CloseIndexRequest{
+		Indices:           req.Indices,
+		IgnoreUnavailable: req.IgnoreUnavailable,
+		AllowNoIndices:    req.AllowNoIndices,
+	}, vrs
+}
+
+// Validate validates the given CloseIndexRequest
+func (r *CloseIndexRequest) Validate() opensearchtools.ValidationResults {
+	return validateIndices("CloseIndexRequest", r.Indices)
+}
+
+// Do executes the [CloseIndexRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *CloseIndexRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.IndicesCloseRequest{
+		Index:             r.Indices,
+		IgnoreUnavailable: ignoreUnavailableParam(r.IgnoreUnavailable),
+		AllowNoIndices:    r.AllowNoIndices,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// AcknowledgedResponse represents the response of the index APIs which only acknowledge the request.
+type AcknowledgedResponse struct {
+	Acknowledged       bool   `json:"acknowledged"`
+	ShardsAcknowledged bool   `json:"shards_acknowledged"`
+	Error              *Error `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of an [AcknowledgedResponse] into an [opensearchtools.AcknowledgedResponse].
+func (r AcknowledgedResponse) toDomain() opensearchtools.AcknowledgedResponse {
+	domainResp := opensearchtools.AcknowledgedResponse{
+		Acknowledged:       r.Acknowledged,
+		ShardsAcknowledged: r.ShardsAcknowledged,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// decodeAcknowledgedResponse decodes the body of osResp into an [AcknowledgedResponse]
+func decodeAcknowledgedResponse(osResp *opensearchapi.Response, vrs opensearchtools.ValidationResults) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	ackResp, err := decodeResponse[AcknowledgedResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		ackResp,
+	)
+	return &resp, nil
+}
+
+// validateIndices validates the indices targeted by the request named requestName are set
+func validateIndices(requestName string, indices []string) opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(indices) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Indices not set on the %s", requestName), true))
+	}
+
+	for _, index := range indices {
+		if index == "" {
+			validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Index cannot be empty on the %s", requestName), true))
+			break
+		}
+	}
+
+	return validationResults
+}
+
+// ignoreUnavailableParam returns the ignore_unavailable query parameter, omitted when false
+func ignoreUnavailableParam(ignoreUnavailable bool) *bool {
+	if !ignoreUnavailable {
+		return nil
+	}
+
+	return &ignoreUnavailable
+}
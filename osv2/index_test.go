@@ -0,0 +1,270 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestCreateIndexRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.CreateIndexRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewCreateIndexRequest(testIndex1).AddAliases(opensearchtools.NewAlias("alias")),
+		},
+		{
+			name:      "Missing index",
+			request:   opensearchtools.NewCreateIndexRequest(""),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing alias name",
+			request:   opensearchtools.NewCreateIndexRequest(testIndex1).AddAliases(opensearchtools.NewAlias("")),
+			wantFatal: true,
+		},
+		{
+			name: "Settings and raw settings",
+			request: opensearchtools.NewCreateIndexRequest(testIndex1).
+				WithSettings(opensearchtools.NewIndexSettings().WithNumberOfShards(1)).
+				WithRawSettings(map[string]any{"number_of_shards": 1}),
+			wantFatal: true,
+		},
+		{
+			name: "Mapping and raw mappings",
+			request: opensearchtools.NewCreateIndexRequest(testIndex1).
+				WithMapping(opensearchtools.NewMapping()).
+				WithMappings(map[string]any{"dynamic": "strict"}),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainCreateIndexRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestCreateIndexRequest_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *opensearchtools.CreateIndexRequest
+		want    string
+	}{
+		{
+			name:    "Empty body",
+			request: opensearchtools.NewCreateIndexRequest(testIndex1),
+			want:    `{}`,
+		},
+		{
+			name: "Settings, mappings and aliases",
+			request: opensearchtools.NewCreateIndexRequest(testIndex1).
+				WithSettings(opensearchtools.NewIndexSettings().WithNumberOfShards(1)).
+				WithMapping(opensearchtools.NewMapping().AddProperty("field", opensearchtools.NewKeywordProperty())).
+				AddAliases(
+					opensearchtools.NewAlias("write_alias").WithIsWriteIndex(true),
+					opensearchtools.NewAlias("filtered_alias").
+						WithFilter(opensearchtools.NewTermQuery("field", "value")).
+						WithRouting("1").
+						WithIndexRouting("2").
+						WithSearchRouting("3"),
+				),
+			want: `{
+				"settings": {"index": {"number_of_shards": "1"}},
+				"mappings": {"properties": {"field": {"type": "keyword"}}},
+				"aliases": {
+					"write_alias": {"is_write_index": true},
+					"filtered_alias": {
+						"filter": {"term": {"field": "value"}},
+						"routing": "1",
+						"index_routing": "2",
+						"search_routing": "3"
+					}
+				}
+			}`,
+		},
+		{
+			name: "Raw settings and mappings",
+			request: opensearchtools.NewCreateIndexRequest(testIndex1).
+				WithRawSettings(map[string]any{"number_of_shards": 1}).
+				WithMappings(map[string]any{"properties": map[string]any{"field": map[string]any{"type": "keyword"}}}).
+				AddAliases(opensearchtools.NewAlias("alias")),
+			want: `{
+				"settings": {"number_of_shards": 1},
+				"mappings": {"properties": {"field": {"type": "keyword"}}},
+				"aliases": {"alias": {}}
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainCreateIndexRequest(tt.request)
+			got, err := req.ToOpenSearchJSON()
+			require.Nil(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestExecutor_CreateIndex(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true, "shards_acknowledged": true, "index": "test_index"}`)
+
+	resp, err := executor.CreateIndex(context.Background(), opensearchtools.NewCreateIndexRequest(testIndex1))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, recorded.Method)
+	require.Equal(t, "/test_index", recorded.Path)
+	require.Equal(t, opensearchtools.CreateIndexResponse{
+		Acknowledged:       true,
+		ShardsAcknowledged: true,
+		Index:              testIndex1,
+	}, resp.Response)
+}
+
+func TestExecutor_CreateIndexExists(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusBadRequest, `{
+		"error": {"type": "resource_already_exists_exception", "reason": "index [test_index/abc] already exists", "index": "test_index"},
+		"status": 400
+	}`)
+
+	resp, err := executor.CreateIndex(context.Background(), opensearchtools.NewCreateIndexRequest(testIndex1))
+	require.Nil(t, err)
+	require.False(t, resp.Response.Acknowledged)
+	require.Equal(t, "resource_already_exists_exception", resp.Response.Error.Type)
+}
+
+func TestExecutor_DeleteIndex(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	req := opensearchtools.NewDeleteIndexRequest(testIndex1, testIndex2).
+		WithIgnoreUnavailable(true).
+		WithAllowNoIndices(false)
+
+	resp, err := executor.DeleteIndex(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, recorded.Method)
+	require.Equal(t, "/test_index,test_index2", recorded.Path)
+	require.Equal(t, "true", recorded.Query.Get("ignore_unavailable"))
+	require.Equal(t, "false", recorded.Query.Get("allow_no_indices"))
+	require.True(t, resp.Response.Acknowledged)
+}
+
+func TestExecutor_IndexExists(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		wantExists bool
+		wantErr    bool
+	}{
+		{
+			name:       "Exists",
+			status:     http.StatusOK,
+			wantExists: true,
+		},
+		{
+			name:   "Missing",
+			status: http.StatusNotFound,
+		},
+		{
+			name:    "Unexpected status",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor, recorded := newRecordingExecutor(t, tt.status, "")
+
+			resp, err := executor.IndexExists(context.Background(), opensearchtools.NewIndexExistsRequest(testIndex1))
+			require.Equal(t, http.MethodHead, recorded.Method)
+			require.Equal(t, "/test_index", recorded.Path)
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.wantExists, resp.Response.Exists)
+		})
+	}
+}
+
+func TestExecutor_OpenIndex(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true, "shards_acknowledged": true}`)
+
+	resp, err := executor.OpenIndex(context.Background(), opensearchtools.NewOpenIndexRequest(testIndex1))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/test_index/_open", recorded.Path)
+	require.Empty(t, recorded.Query.Get("ignore_unavailable"))
+	require.Equal(t, opensearchtools.AcknowledgedResponse{Acknowledged: true, ShardsAcknowledged: true}, resp.Response)
+}
+
+func TestExecutor_CloseIndex(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"acknowledged": true, "shards_acknowledged": true, "indices": {"test_index": {"closed": true}}}`)
+
+	resp, err := executor.CloseIndex(context.Background(), opensearchtools.NewCloseIndexRequest(testIndex1).WithIgnoreUnavailable(true))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/test_index/_close", recorded.Path)
+	require.Equal(t, "true", recorded.Query.Get("ignore_unavailable"))
+	require.True(t, resp.Response.Acknowledged)
+}
+
+func TestIndicesRequests_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		validate  func() opensearchtools.ValidationResults
+		wantFatal bool
+	}{
+		{
+			name: "Delete index",
+			validate: func() opensearchtools.ValidationResults {
+				req, _ := FromDomainDeleteIndexRequest(opensearchtools.NewDeleteIndexRequest(testIndex1))
+				return req.Validate()
+			},
+		},
+		{
+			name: "Delete without indices",
+			validate: func() opensearchtools.ValidationResults {
+				req, _ := FromDomainDeleteIndexRequest(opensearchtools.NewDeleteIndexRequest())
+				return req.Validate()
+			},
+			wantFatal: true,
+		},
+		{
+			name: "Exists with empty index",
+			validate: func() opensearchtools.ValidationResults {
+				req, _ := FromDomainIndexExistsRequest(opensearchtools.NewIndexExistsRequest(testIndex1, ""))
+				return req.Validate()
+			},
+			wantFatal: true,
+		},
+		{
+			name: "Open without indices",
+			validate: func() opensearchtools.ValidationResults {
+				req, _ := FromDomainOpenIndexRequest(opensearchtools.NewOpenIndexRequest())
+				return req.Validate()
+			},
+			wantFatal: true,
+		},
+		{
+			name: "Close without indices",
+			validate: func() opensearchtools.ValidationResults {
+				req, _ := FromDomainCloseIndexRequest(opensearchtools.NewCloseIndexRequest())
+				return req.Validate()
+			},
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrs := tt.validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
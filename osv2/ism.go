@@ -0,0 +1,1129 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// ismPath is the path prefix of the Index State Management plugin APIs
+var ismPath = []string{"_plugins", "_ism"}
+
+// ismPathSegments appends the segments to the path prefix of the Index State Management plugin APIs
+func ismPathSegments(segments ...string) []string {
+	return append(append([]string{}, ismPath...), segments...)
+}
+
+// ISMPolicy is the serializable form of an [opensearchtools.ISMPolicy] in OpenSearch V2, without its ID.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/policies/
+type ISMPolicy struct {
+	Description  string        `json:"description,omitempty"`
+	DefaultState string        `json:"default_state"`
+	States       []ISMState    `json:"states"`
+	ISMTemplate  []ISMTemplate `json:"ism_template,omitempty"`
+}
+
+// ISMTemplate is the serializable form of an [opensearchtools.ISMTemplate] in OpenSearch V2.
+type ISMTemplate struct {
+	IndexPatterns []string `json:"index_patterns"`
+	Priority      int      `json:"priority,omitempty"`
+}
+
+// ISMState is the serializable form of an [opensearchtools.ISMState] in OpenSearch V2.
+type ISMState struct {
+	Name        string          `json:"name"`
+	Actions     []ISMAction     `json:"actions"`
+	Transitions []ISMTransition `json:"transitions"`
+}
+
+// ISMAction is the serializable form of an [opensearchtools.ISMAction] in OpenSearch V2.
+// The parameters of the action are nested under its type.
+type ISMAction struct {
+	Type       string
+	Parameters json.RawMessage
+	Timeout    string
+	Retry      *ISMRetry
+}
+
+// ismActionParameters are the parameters of the action types with typed fields
+type ismActionParameters struct {
+	MinSize             string `json:"min_size,omitempty"`
+	MinPrimaryShardSize string `json:"min_primary_shard_size,omitempty"`
+	MinDocCount         int64  `json:"min_doc_count,omitempty"`
+	MinIndexAge         string `json:"min_index_age,omitempty"`
+	NumberOfReplicas    *int   `json:"number_of_replicas,omitempty"`
+	MaxNumSegments      int    `json:"max_num_segments,omitempty"`
+}
+
+// ISMRetry is the serializable form of an [opensearchtools.ISMRetry] in OpenSearch V2.
+type ISMRetry struct {
+	Count   int    `json:"count"`
+	Backoff string `json:"backoff,omitempty"`
+	Delay   string `json:"delay,omitempty"`
+}
+
+// ISMTransition is the serializable form of an [opensearchtools.ISMTransition] in OpenSearch V2.
+type ISMTransition struct {
+	StateName  string                   `json:"state_name"`
+	Conditions *ISMTransitionConditions `json:"conditions,omitempty"`
+}
+
+// ISMTransitionConditions are the conditions of an [ISMTransition].
+type ISMTransitionConditions struct {
+	MinIndexAge    string `json:"min_index_age,omitempty"`
+	MinDocCount    int64  `json:"min_doc_count,omitempty"`
+	MinSize        string `json:"min_size,omitempty"`
+	MinRolloverAge string `json:"min_rollover_age,omitempty"`
+}
+
+// FromDomainISMPolicy creates a new [ISMPolicy] from the given [opensearchtools.ISMPolicy].
+// An error is returned if the parameters of an action can't be marshaled to JSON.
+func FromDomainISMPolicy(policy *opensearchtools.ISMPolicy) (*ISMPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	converted := &ISMPolicy{
+		Description:  policy.Description,
+		DefaultState: policy.DefaultState,
+		States:       make([]ISMState, 0, len(policy.States)),
+	}
+
+	for _, state := range policy.States {
+		if state == nil {
+			continue
+		}
+
+		convertedState := ISMState{
+			Name:        state.Name,
+			Actions:     make([]ISMAction, 0, len(state.Actions)),
+			Transitions: make([]ISMTransition, 0, len(state.Transitions)),
+		}
+
+		for _, action := range state.Actions {
+			if action == nil {
+				continue
+			}
+
+			convertedAction, err := fromDomainISMAction(action)
+			if err != nil {
+				return nil, err
+			}
+
+			convertedState.Actions = append(convertedState.Actions, convertedAction)
+		}
+
+		for _, transition := range state.Transitions {
+			if transition == nil {
+				continue
+			}
+
+			convertedState.Transitions = append(convertedState.Transitions, fromDomainISMTransition(transition))
+		}
+
+		converted.States = append(converted.States, convertedState)
+	}
+
+	for _, template := range policy.ISMTemplates {
+		if template == nil {
+			continue
+		}
+
+		converted.ISMTemplate = append(converted.ISMTemplate, ISMTemplate{
+			IndexPatterns: template.IndexPatterns,
+			Priority:      template.Priority,
+		})
+	}
+
+	return converted, nil
+}
+
+// fromDomainISMAction creates a new [ISMAction] from the given [opensearchtools.ISMAction]
+func fromDomainISMAction(action *opensearchtools.ISMAction) (ISMAction, error) {
+	converted := ISMAction{
+		Type: string(action.Type),
+	}
+
+	var parameters any
+	switch action.Type {
+	case opensearchtools.ISMRollover:
+		rollover := ismActionParameters{
+			MinSize:             action.MinSize,
+			MinPrimaryShardSize: action.MinPrimaryShardSize,
+			MinDocCount:         action.MinDocCount,
+		}
+
+		if action.MinIndexAge > 0 {
+			rollover.MinIndexAge = formatDuration(action.MinIndexAge)
+		}
+
+		parameters = rollover
+	case opensearchtools.ISMDelete:
+		parameters = ismActionParameters{}
+	case opensearchtools.ISMReplicaCount:
+		numberOfReplicas := action.NumberOfReplicas
+		parameters = ismActionParameters{NumberOfReplicas: &numberOfReplicas}
+	case opensearchtools.ISMForceMerge:
+		parameters = ismActionParameters{MaxNumSegments: action.MaxNumSegments}
+	default:
+		if action.Parameters != nil {
+			parameters = action.Parameters
+		} else {
+			parameters = map[string]any{}
+		}
+	}
+
+	parametersJSON, err := json.Marshal(parameters)
+	if err != nil {
+		return converted, err
+	}
+
+	converted.Parameters = parametersJSON
+
+	if action.Timeout > 0 {
+		converted.Timeout = formatDuration(action.Timeout)
+	}
+
+	if retry := action.Retry; retry != nil {
+		converted.Retry = &ISMRetry{
+			Count:   retry.Count,
+			Backoff: string(retry.Backoff),
+		}
+
+		if retry.Delay > 0 {
+			converted.Retry.Delay = formatDuration(retry.Delay)
+		}
+	}
+
+	return converted, nil
+}
+
+// fromDomainISMTransition creates a new [ISMTransition] from the given [opensearchtools.ISMTransition]
+func fromDomainISMTransition(transition *opensearchtools.ISMTransition) ISMTransition {
+	converted := ISMTransition{
+		StateName: transition.StateName,
+	}
+
+	conditions := ISMTransitionConditions{
+		MinDocCount: transition.MinDocCount,
+		MinSize:     transition.MinSize,
+	}
+
+	if transition.MinIndexAge > 0 {
+		conditions.MinIndexAge = formatDuration(transition.MinIndexAge)
+	}
+
+	if transition.MinRolloverAge > 0 {
+		conditions.MinRolloverAge = formatDuration(transition.MinRolloverAge)
+	}
+
+	if conditions != (ISMTransitionConditions{}) {
+		converted.Conditions = &conditions
+	}
+
+	return converted
+}
+
+// MarshalJSON marshals the ISMAction into the JSON shape expected by OpenSearch.
+func (a ISMAction) MarshalJSON() ([]byte, error) {
+	source := make(map[string]any)
+
+	if len(a.Parameters) > 0 {
+		source[a.Type] = a.Parameters
+	} else {
+		source[a.Type] = map[string]any{}
+	}
+
+	if a.Timeout != "" {
+		source["timeout"] = a.Timeout
+	}
+
+	if a.Retry != nil {
+		source["retry"] = a.Retry
+	}
+
+	return json.Marshal(source)
+}
+
+// UnmarshalJSON unmarshals an ISMAction returned by OpenSearch. The only key besides timeout and retry is the type.
+func (a *ISMAction) UnmarshalJSON(data []byte) error {
+	var source map[string]json.RawMessage
+	if err := json.Unmarshal(data, &source); err != nil {
+		return err
+	}
+
+	var action ISMAction
+	for key, value := range source {
+		switch key {
+		case "timeout":
+			if err := json.Unmarshal(value, &action.Timeout); err != nil {
+				return err
+			}
+		case "retry":
+			if err := json.Unmarshal(value, &action.Retry); err != nil {
+				return err
+			}
+		default:
+			action.Type = key
+			action.Parameters = value
+		}
+	}
+
+	*a = action
+	return nil
+}
+
+// toDomain converts this instance of an [ISMAction] into an [opensearchtools.ISMAction].
+// Values which can't be parsed are left unset.
+func (a ISMAction) toDomain() *opensearchtools.ISMAction {
+	action := &opensearchtools.ISMAction{
+		Type: opensearchtools.ISMActionType(a.Type),
+	}
+
+	switch action.Type {
+	case opensearchtools.ISMRollover, opensearchtools.ISMDelete, opensearchtools.ISMReplicaCount, opensearchtools.ISMForceMerge:
+		var parameters ismActionParameters
+		_ = json.Unmarshal(a.Parameters, &parameters)
+
+		action.MinSize = parameters.MinSize
+		action.MinPrimaryShardSize = parameters.MinPrimaryShardSize
+		action.MinDocCount = parameters.MinDocCount
+		action.MaxNumSegments = parameters.MaxNumSegments
+
+		if minIndexAge, err := parseDuration(parameters.MinIndexAge); err == nil {
+			action.MinIndexAge = minIndexAge
+		}
+
+		if parameters.NumberOfReplicas != nil {
+			action.NumberOfReplicas = *parameters.NumberOfReplicas
+		}
+	default:
+		_ = json.Unmarshal(a.Parameters, &action.Parameters)
+	}
+
+	if timeout, err := parseDuration(a.Timeout); err == nil {
+		action.Timeout = timeout
+	}
+
+	if a.Retry != nil {
+		action.Retry = &opensearchtools.ISMRetry{
+			Count:   a.Retry.Count,
+			Backoff: opensearchtools.ISMBackoff(a.Retry.Backoff),
+		}
+
+		if delay, err := parseDuration(a.Retry.Delay); err == nil {
+			action.Retry.Delay = delay
+		}
+	}
+
+	return action
+}
+
+// toDomain converts this instance of an [ISMTransition] into an [opensearchtools.ISMTransition].
+// Durations which can't be parsed are left unset.
+func (t ISMTransition) toDomain() *opensearchtools.ISMTransition {
+	transition := opensearchtools.NewISMTransition(t.StateName)
+	if t.Conditions == nil {
+		return transition
+	}
+
+	transition.MinDocCount = t.Conditions.MinDocCount
+	transition.MinSize = t.Conditions.MinSize
+
+	if minIndexAge, err := parseDuration(t.Conditions.MinIndexAge); err == nil {
+		transition.MinIndexAge = minIndexAge
+	}
+
+	if minRolloverAge, err := parseDuration(t.Conditions.MinRolloverAge); err == nil {
+		transition.MinRolloverAge = minRolloverAge
+	}
+
+	return transition
+}
+
+// toDomain converts this instance of an [ISMPolicy] into an [opensearchtools.ISMPolicy] with the given ID.
+func (p *ISMPolicy) toDomain(id string) *opensearchtools.ISMPolicy {
+	policy := opensearchtools.NewISMPolicy(id, p.DefaultState).WithDescription(p.Description)
+
+	for _, state := range p.States {
+		domainState := opensearchtools.NewISMState(state.Name)
+
+		for _, action := range state.Actions {
+			domainState.AddActions(action.toDomain())
+		}
+
+		for _, transition := range state.Transitions {
+			domainState.AddTransitions(transition.toDomain())
+		}
+
+		policy.AddStates(domainState)
+	}
+
+	for _, template := range p.ISMTemplate {
+		policy.AddISMTemplate(template.Priority, template.IndexPatterns...)
+	}
+
+	return policy
+}
+
+// PutISMPolicyRequest is a serializable form of [opensearchtools.PutISMPolicyRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/api/#create-policy
+type PutISMPolicyRequest struct {
+	// PolicyID of the policy
+	PolicyID string
+
+	// Policy to create or update
+	Policy *ISMPolicy
+
+	// IfSeqNo only updates the policy if it has this sequence number
+	IfSeqNo uint64
+
+	// IfPrimaryTerm only updates the policy if it has this primary term
+	IfPrimaryTerm uint64
+}
+
+// FromDomainPutISMPolicyRequest creates a new [PutISMPolicyRequest] from the given [opensearchtools.PutISMPolicyRequest].
+func FromDomainPutISMPolicyRequest(req *opensearchtools.PutISMPolicyRequest) (PutISMPolicyRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	putRequest := PutISMPolicyRequest{
+		IfSeqNo:       req.IfSeqNo,
+		IfPrimaryTerm: req.IfPrimaryTerm,
+	}
+
+	if req.Policy == nil {
+		return putRequest, vrs
+	}
+
+	putRequest.PolicyID = req.Policy.ID
+	policy, cErr := FromDomainISMPolicy(req.Policy)
+	if cErr != nil {
+		vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+		return putRequest, vrs
+	}
+
+	putRequest.Policy = policy
+
+	return putRequest, vrs
+}
+
+// Validate validates the given PutISMPolicyRequest
+func (r *PutISMPolicyRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.PolicyID == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("PolicyID not set on the PutISMPolicyRequest", true))
+	}
+
+	if r.Policy == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Policy not set on the PutISMPolicyRequest", true))
+		return validationResults
+	}
+
+	if r.Policy.DefaultState == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("DefaultState not set on the PutISMPolicyRequest", true))
+	}
+
+	states := make(map[string]bool, len(r.Policy.States))
+	for _, state := range r.Policy.States {
+		if state.Name == "" {
+			validationResults.Add(opensearchtools.NewValidationResult("State name not set on the PutISMPolicyRequest", true))
+		}
+
+		states[state.Name] = true
+
+		for _, action := range state.Actions {
+			if action.Type == "" {
+				validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Action type not set on the state %s of the PutISMPolicyRequest", state.Name), true))
+			}
+		}
+	}
+
+	if r.Policy.DefaultState != "" && !states[r.Policy.DefaultState] {
+		validationResults.Add(opensearchtools.NewValidationResult(fmt.Sprintf("Unknown default state %q on the PutISMPolicyRequest", r.Policy.DefaultState), true))
+	}
+
+	for _, state := range r.Policy.States {
+		for _, transition := range state.Transitions {
+			if !states[transition.StateName] {
+				validationResults.Add(opensearchtools.NewValidationResult(
+					fmt.Sprintf("Unknown state %q in a transition of the state %s of the PutISMPolicyRequest", transition.StateName, state.Name), true))
+			}
+		}
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the PutISMPolicyRequest into the JSON shape expected by OpenSearch.
+func (r *PutISMPolicyRequest) ToOpenSearchJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"policy": r.Policy,
+	})
+}
+
+// Do executes the [PutISMPolicyRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [ISMPolicyResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *PutISMPolicyRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ISMPolicyResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	params := url.Values{}
+	if r.IfPrimaryTerm > 0 {
+		params.Set("if_seq_no", strconv.FormatUint(r.IfSeqNo, 10))
+		params.Set("if_primary_term", strconv.FormatUint(r.IfPrimaryTerm, 10))
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodPut, ismPathSegments("policies", r.PolicyID), params, bytes.NewReader(bodyBytes))
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	// The policy is nested once more in the response to a put
+	putResp, err := decodeResponse[struct {
+		ISMPolicyResponse
+		Policy struct {
+			Policy *ISMPolicy `json:"policy"`
+		} `json:"policy"`
+	}](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	policyResp := putResp.ISMPolicyResponse
+	policyResp.Policy = putResp.Policy.Policy
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		policyResp,
+	)
+	return &resp, nil
+}
+
+// GetISMPolicyRequest is a serializable form of [opensearchtools.GetISMPolicyRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/api/#get-policy
+type GetISMPolicyRequest struct {
+	// PolicyID of the policy to get
+	PolicyID string
+}
+
+// FromDomainGetISMPolicyRequest creates a new [GetISMPolicyRequest] from the given [opensearchtools.GetISMPolicyRequest].
+func FromDomainGetISMPolicyRequest(req *opensearchtools.GetISMPolicyRequest) (GetISMPolicyRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetISMPolicyRequest{
+		PolicyID: req.PolicyID,
+	}, vrs
+}
+
+// Validate validates the given GetISMPolicyRequest
+func (r *GetISMPolicyRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.PolicyID == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("PolicyID not set on the GetISMPolicyRequest", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [GetISMPolicyRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [ISMPolicyResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetISMPolicyRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ISMPolicyResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodGet, ismPathSegments("policies", r.PolicyID), nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	policyResp, err := decodeResponse[ISMPolicyResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		policyResp,
+	)
+	return &resp, nil
+}
+
+// ISMPolicyResponse represents the response for a [PutISMPolicyRequest] or a [GetISMPolicyRequest].
+type ISMPolicyResponse struct {
+	ID          string     `json:"_id"`
+	Version     uint64     `json:"_version"`
+	SeqNo       uint64     `json:"_seq_no"`
+	PrimaryTerm uint64     `json:"_primary_term"`
+	Policy      *ISMPolicy `json:"policy"`
+	Error       *Error     `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of an [ISMPolicyResponse] into an [opensearchtools.ISMPolicyResponse].
+func (r ISMPolicyResponse) toDomain() opensearchtools.ISMPolicyResponse {
+	domainResp := opensearchtools.ISMPolicyResponse{
+		ID:          r.ID,
+		Version:     r.Version,
+		SeqNo:       r.SeqNo,
+		PrimaryTerm: r.PrimaryTerm,
+	}
+
+	if r.Policy != nil {
+		domainResp.Policy = r.Policy.toDomain(r.ID)
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// DeleteISMPolicyRequest is a serializable form of [opensearchtools.DeleteISMPolicyRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/api/#delete-policy
+type DeleteISMPolicyRequest struct {
+	// PolicyID of the policy to delete
+	PolicyID string
+}
+
+// FromDomainDeleteISMPolicyRequest creates a new [DeleteISMPolicyRequest] from the given [opensearchtools.DeleteISMPolicyRequest].
+func FromDomainDeleteISMPolicyRequest(req *opensearchtools.DeleteISMPolicyRequest) (DeleteISMPolicyRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DeleteISMPolicyRequest{
+		PolicyID: req.PolicyID,
+	}, vrs
+}
+
+// Validate validates the given DeleteISMPolicyRequest
+func (r *DeleteISMPolicyRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.PolicyID == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("PolicyID not set on the DeleteISMPolicyRequest", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [DeleteISMPolicyRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [DeleteISMPolicyResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeleteISMPolicyRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[DeleteISMPolicyResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodDelete, ismPathSegments("policies", r.PolicyID), nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	deleteResp, err := decodeResponse[DeleteISMPolicyResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		deleteResp,
+	)
+	return &resp, nil
+}
+
+// DeleteISMPolicyResponse represents the response for a [DeleteISMPolicyRequest].
+type DeleteISMPolicyResponse struct {
+	ID      string `json:"_id"`
+	Version uint64 `json:"_version"`
+	Result  string `json:"result"`
+	Error   *Error `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [DeleteISMPolicyResponse] into an [opensearchtools.DeleteISMPolicyResponse].
+func (r DeleteISMPolicyResponse) toDomain() opensearchtools.DeleteISMPolicyResponse {
+	domainResp := opensearchtools.DeleteISMPolicyResponse{
+		ID:      r.ID,
+		Version: r.Version,
+		Result:  r.Result,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// AddISMPolicyRequest is a serializable form of [opensearchtools.AddISMPolicyRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/api/#add-policy
+type AddISMPolicyRequest struct {
+	// PolicyID of the policy to apply
+	PolicyID string
+
+	// Indices to manage, wildcards are supported
+	Indices []string
+}
+
+// FromDomainAddISMPolicyRequest creates a new [AddISMPolicyRequest] from the given [opensearchtools.AddISMPolicyRequest].
+func FromDomainAddISMPolicyRequest(req *opensearchtools.AddISMPolicyRequest) (AddISMPolicyRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return AddISMPolicyRequest{
+		PolicyID: req.PolicyID,
+		Indices:  req.Indices,
+	}, vrs
+}
+
+// Validate validates the given AddISMPolicyRequest
+func (r *AddISMPolicyRequest) Validate() opensearchtools.ValidationResults {
+	validationResults := validateIndices("AddISMPolicyRequest", r.Indices)
+
+	if r.PolicyID == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("PolicyID not set on the AddISMPolicyRequest", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the AddISMPolicyRequest into the JSON shape expected by OpenSearch.
+func (r *AddISMPolicyRequest) ToOpenSearchJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"policy_id": r.PolicyID,
+	})
+}
+
+// Do executes the [AddISMPolicyRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [ISMIndicesResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *AddISMPolicyRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ISMIndicesResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	return doISMIndicesRequest(ctx, client, "add", r.Indices, bodyBytes, vrs)
+}
+
+// RemoveISMPolicyRequest is a serializable form of [opensearchtools.RemoveISMPolicyRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/api/#remove-policy
+type RemoveISMPolicyRequest struct {
+	// Indices to stop managing, wildcards are supported
+	Indices []string
+}
+
+// FromDomainRemoveISMPolicyRequest creates a new [RemoveISMPolicyRequest] from the given [opensearchtools.RemoveISMPolicyRequest].
+func FromDomainRemoveISMPolicyRequest(req *opensearchtools.RemoveISMPolicyRequest) (RemoveISMPolicyRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return RemoveISMPolicyRequest{
+		Indices: req.Indices,
+	}, vrs
+}
+
+// Validate validates the given RemoveISMPolicyRequest
+func (r *RemoveISMPolicyRequest) Validate() opensearchtools.ValidationResults {
+	return validateIndices("RemoveISMPolicyRequest", r.Indices)
+}
+
+// Do executes the [RemoveISMPolicyRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [ISMIndicesResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *RemoveISMPolicyRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ISMIndicesResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	return doISMIndicesRequest(ctx, client, "remove", r.Indices, nil, vrs)
+}
+
+// ChangeISMPolicyRequest is a serializable form of [opensearchtools.ChangeISMPolicyRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/api/#update-managed-index-policy
+type ChangeISMPolicyRequest struct {
+	// PolicyID of the policy to switch to
+	PolicyID string
+
+	// Indices whose policy to change, wildcards are supported
+	Indices []string
+
+	// State of the new policy the indices move to
+	State string
+
+	// IncludeStates limits the change to the indices currently in one of these states
+	IncludeStates []string
+}
+
+// FromDomainChangeISMPolicyRequest creates a new [ChangeISMPolicyRequest] from the given [opensearchtools.ChangeISMPolicyRequest].
+func FromDomainChangeISMPolicyRequest(req *opensearchtools.ChangeISMPolicyRequest) (ChangeISMPolicyRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return ChangeISMPolicyRequest{
+		PolicyID:      req.PolicyID,
+		Indices:       req.Indices,
+		State:         req.State,
+		IncludeStates: req.IncludeStates,
+	}, vrs
+}
+
+// Validate validates the given ChangeISMPolicyRequest
+func (r *ChangeISMPolicyRequest) Validate() opensearchtools.ValidationResults {
+	validationResults := validateIndices("ChangeISMPolicyRequest", r.Indices)
+
+	if r.PolicyID == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("PolicyID not set on the ChangeISMPolicyRequest", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the ChangeISMPolicyRequest into the JSON shape expected by OpenSearch.
+func (r *ChangeISMPolicyRequest) ToOpenSearchJSON() ([]byte, error) {
+	source := map[string]any{
+		"policy_id": r.PolicyID,
+	}
+
+	if r.State != "" {
+		source["state"] = r.State
+	}
+
+	if len(r.IncludeStates) > 0 {
+		include := make([]map[string]string, 0, len(r.IncludeStates))
+		for _, state := range r.IncludeStates {
+			include = append(include, map[string]string{"state": state})
+		}
+
+		source["include"] = include
+	}
+
+	return json.Marshal(source)
+}
+
+// Do executes the [ChangeISMPolicyRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [ISMIndicesResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *ChangeISMPolicyRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ISMIndicesResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	return doISMIndicesRequest(ctx, client, "change_policy", r.Indices, bodyBytes, vrs)
+}
+
+// doISMIndicesRequest posts the body to the ISM API updating the managed indices
+func doISMIndicesRequest(ctx context.Context, client *opensearch.Client, api string, indices []string, body []byte, vrs opensearchtools.ValidationResults) (*opensearchtools.OpenSearchResponse[ISMIndicesResponse], error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodPost, ismPathSegments(api, strings.Join(indices, ",")), nil, bodyReader)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	indicesResp, err := decodeResponse[ISMIndicesResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		indicesResp,
+	)
+	return &resp, nil
+}
+
+// ISMIndicesResponse represents the response for an [AddISMPolicyRequest], a [RemoveISMPolicyRequest]
+// or a [ChangeISMPolicyRequest].
+type ISMIndicesResponse struct {
+	UpdatedIndices int              `json:"updated_indices"`
+	Failures       bool             `json:"failures"`
+	FailedIndices  []ISMFailedIndex `json:"failed_indices"`
+	Error          *Error           `json:"error,omitempty"`
+}
+
+// ISMFailedIndex is an index which couldn't be updated in an [ISMIndicesResponse].
+type ISMFailedIndex struct {
+	IndexName string `json:"index_name"`
+	IndexUUID string `json:"index_uuid"`
+	Reason    string `json:"reason"`
+}
+
+// toDomain converts this instance of an [ISMIndicesResponse] into an [opensearchtools.ISMIndicesResponse].
+func (r ISMIndicesResponse) toDomain() opensearchtools.ISMIndicesResponse {
+	domainResp := opensearchtools.ISMIndicesResponse{
+		UpdatedIndices: r.UpdatedIndices,
+	}
+
+	for _, failed := range r.FailedIndices {
+		domainResp.FailedIndices = append(domainResp.FailedIndices, opensearchtools.ISMFailedIndex{
+			Index:     failed.IndexName,
+			IndexUUID: failed.IndexUUID,
+			Reason:    failed.Reason,
+		})
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// ExplainISMRequest is a serializable form of [opensearchtools.ExplainISMRequest] specific to OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/ism/api/#explain-index
+type ExplainISMRequest struct {
+	// Indices to explain, wildcards are supported
+	Indices []string
+}
+
+// FromDomainExplainISMRequest creates a new [ExplainISMRequest] from the given [opensearchtools.ExplainISMRequest].
+func FromDomainExplainISMRequest(req *opensearchtools.ExplainISMRequest) (ExplainISMRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return ExplainISMRequest{
+		Indices: req.Indices,
+	}, vrs
+}
+
+// Validate validates the given ExplainISMRequest
+func (r *ExplainISMRequest) Validate() opensearchtools.ValidationResults {
+	return validateIndices("ExplainISMRequest", r.Indices)
+}
+
+// Do executes the [ExplainISMRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [ExplainISMResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *ExplainISMRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ExplainISMResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodGet, ismPathSegments("explain", strings.Join(r.Indices, ",")), nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var explainResp ExplainISMResponse
+	if osResp.IsError() {
+		errResp, err := decodeResponse[errorResponse](osResp)
+		if err != nil {
+			return nil, err
+		}
+
+		explainResp.Error = errResp.Error
+	} else {
+		body, err := decodeResponse[map[string]json.RawMessage](osResp)
+		if err != nil {
+			return nil, err
+		}
+
+		if total, ok := body["total_managed_indices"]; ok {
+			if err := json.Unmarshal(total, &explainResp.TotalManagedIndices); err != nil {
+				return nil, err
+			}
+
+			delete(body, "total_managed_indices")
+		}
+
+		explainResp.Indices = make(map[string]ISMExplanation, len(body))
+		for index, rawExplanation := range body {
+			var explanation ISMExplanation
+			if err := json.Unmarshal(rawExplanation, &explanation); err != nil {
+				return nil, err
+			}
+
+			explainResp.Indices[index] = explanation
+		}
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		explainResp,
+	)
+	return &resp, nil
+}
+
+// ExplainISMResponse represents the response for an [ExplainISMRequest].
+type ExplainISMResponse struct {
+	Indices             map[string]ISMExplanation
+	TotalManagedIndices int
+	Error               *Error
+}
+
+// ISMExplanation is the management status of an index in an [ExplainISMResponse].
+type ISMExplanation struct {
+	PolicyID      string         `json:"policy_id"`
+	IndexPolicyID *string        `json:"index.plugins.index_state_management.policy_id"`
+	Enabled       *bool          `json:"enabled"`
+	RolledOver    bool           `json:"rolled_over"`
+	State         *ISMStepStatus `json:"state"`
+	Action        *ISMStepStatus `json:"action"`
+	Step          *ISMStepStatus `json:"step"`
+	Info          map[string]any `json:"info"`
+}
+
+// ISMStepStatus is the status of the state, action or step of an [ISMExplanation].
+type ISMStepStatus struct {
+	Name            string `json:"name"`
+	StartTime       int64  `json:"start_time"`
+	Failed          bool   `json:"failed"`
+	ConsumedRetries int    `json:"consumed_retries"`
+	StepStatus      string `json:"step_status"`
+}
+
+// toDomain converts this instance of an [ISMExplanation] into an [opensearchtools.ISMExplanation].
+func (e ISMExplanation) toDomain() *opensearchtools.ISMExplanation {
+	explanation := &opensearchtools.ISMExplanation{
+		PolicyID:   e.PolicyID,
+		RolledOver: e.RolledOver,
+		Info:       e.Info,
+	}
+
+	if explanation.PolicyID == "" && e.IndexPolicyID != nil {
+		explanation.PolicyID = *e.IndexPolicyID
+	}
+
+	if e.Enabled != nil {
+		explanation.Enabled = *e.Enabled
+	}
+
+	if e.State != nil {
+		explanation.State = e.State.Name
+		explanation.StateStartTime = time.UnixMilli(e.State.StartTime)
+	}
+
+	if e.Action != nil {
+		explanation.Action = e.Action.Name
+		explanation.ActionStartTime = time.UnixMilli(e.Action.StartTime)
+		explanation.Failed = e.Action.Failed
+		explanation.ConsumedRetries = e.Action.ConsumedRetries
+	}
+
+	if e.Step != nil {
+		explanation.Step = e.Step.Name
+		explanation.StepStatus = e.Step.StepStatus
+	}
+
+	return explanation
+}
+
+// toDomain converts this instance of an [ExplainISMResponse] into an [opensearchtools.ExplainISMResponse].
+func (r ExplainISMResponse) toDomain() opensearchtools.ExplainISMResponse {
+	domainResp := opensearchtools.ExplainISMResponse{
+		TotalManagedIndices: r.TotalManagedIndices,
+	}
+
+	if r.Indices != nil {
+		domainResp.Indices = make(map[string]*opensearchtools.ISMExplanation, len(r.Indices))
+		for index, explanation := range r.Indices {
+			domainResp.Indices[index] = explanation.toDomain()
+		}
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
@@ -0,0 +1,336 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// testISMPolicy builds a hot-warm-delete policy using every typed action
+func testISMPolicy() *opensearchtools.ISMPolicy {
+	return opensearchtools.NewISMPolicy("logs", "hot").
+		WithDescription("Rolls logs over daily and deletes them after 30 days").
+		AddStates(
+			opensearchtools.NewISMState("hot").
+				AddActions(opensearchtools.NewISMRolloverAction().
+					WithMinSize("50gb").
+					WithMinDocCount(1000000).
+					WithMinIndexAge(24*time.Hour).
+					WithRetry(&opensearchtools.ISMRetry{Count: 3, Backoff: opensearchtools.ISMBackoffExponential, Delay: time.Minute})).
+				AddTransitions(opensearchtools.NewISMTransition("warm").WithMinRolloverAge(time.Hour)),
+			opensearchtools.NewISMState("warm").
+				AddActions(
+					opensearchtools.NewISMReplicaCountAction(0),
+					opensearchtools.NewISMForceMergeAction(1).WithTimeout(2*time.Hour),
+					opensearchtools.NewISMAction("read_only", nil),
+				).
+				AddTransitions(opensearchtools.NewISMTransition("delete").WithMinIndexAge(30*24*time.Hour)),
+			opensearchtools.NewISMState("delete").AddActions(opensearchtools.NewISMDeleteAction()),
+		).
+		AddISMTemplate(100, "logs-*")
+}
+
+// testISMPolicyJSON is the OpenSearch JSON of testISMPolicy
+const testISMPolicyJSON = `{
+	"description": "Rolls logs over daily and deletes them after 30 days",
+	"default_state": "hot",
+	"states": [
+		{
+			"name": "hot",
+			"actions": [{
+				"rollover": {"min_size": "50gb", "min_doc_count": 1000000, "min_index_age": "86400000ms"},
+				"retry": {"count": 3, "backoff": "exponential", "delay": "60000ms"}
+			}],
+			"transitions": [{"state_name": "warm", "conditions": {"min_rollover_age": "3600000ms"}}]
+		},
+		{
+			"name": "warm",
+			"actions": [
+				{"replica_count": {"number_of_replicas": 0}},
+				{"force_merge": {"max_num_segments": 1}, "timeout": "7200000ms"},
+				{"read_only": {}}
+			],
+			"transitions": [{"state_name": "delete", "conditions": {"min_index_age": "2592000000ms"}}]
+		},
+		{
+			"name": "delete",
+			"actions": [{"delete": {}}],
+			"transitions": []
+		}
+	],
+	"ism_template": [{"index_patterns": ["logs-*"], "priority": 100}]
+}`
+
+func TestPutISMPolicyRequest_ToOpenSearchJSON(t *testing.T) {
+	req, vrs := FromDomainPutISMPolicyRequest(opensearchtools.NewPutISMPolicyRequest(testISMPolicy()))
+	require.False(t, vrs.IsFatal())
+
+	got, err := req.ToOpenSearchJSON()
+	require.Nil(t, err)
+	require.JSONEq(t, `{"policy": `+testISMPolicyJSON+`}`, string(got))
+}
+
+func TestPutISMPolicyRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    *opensearchtools.ISMPolicy
+		wantFatal bool
+	}{
+		{
+			name:   "Valid policy",
+			policy: testISMPolicy(),
+		},
+		{
+			name:      "Missing policy",
+			wantFatal: true,
+		},
+		{
+			name:      "Missing ID",
+			policy:    opensearchtools.NewISMPolicy("", "hot").AddStates(opensearchtools.NewISMState("hot")),
+			wantFatal: true,
+		},
+		{
+			name:      "Unknown default state",
+			policy:    opensearchtools.NewISMPolicy("logs", "hot").AddStates(opensearchtools.NewISMState("warm")),
+			wantFatal: true,
+		},
+		{
+			name: "Unknown transition state",
+			policy: opensearchtools.NewISMPolicy("logs", "hot").AddStates(
+				opensearchtools.NewISMState("hot").AddTransitions(opensearchtools.NewISMTransition("delete"))),
+			wantFatal: true,
+		},
+		{
+			name: "Missing action type",
+			policy: opensearchtools.NewISMPolicy("logs", "hot").AddStates(
+				opensearchtools.NewISMState("hot").AddActions(&opensearchtools.ISMAction{})),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainPutISMPolicyRequest(opensearchtools.NewPutISMPolicyRequest(tt.policy))
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_PutISMPolicy(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"_id": "logs",
+		"_version": 2,
+		"_primary_term": 1,
+		"_seq_no": 7,
+		"policy": {"policy": {"policy_id": "logs", "default_state": "hot", "states": [{"name": "hot", "actions": [], "transitions": []}]}}
+	}`)
+
+	req := opensearchtools.NewPutISMPolicyRequest(testISMPolicy()).WithIfSeqNoPrimaryTerm(6, 1)
+	resp, err := executor.PutISMPolicy(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, recorded.Method)
+	require.Equal(t, "/_plugins/_ism/policies/logs", recorded.Path)
+	require.Equal(t, "6", recorded.Query.Get("if_seq_no"))
+	require.Equal(t, "1", recorded.Query.Get("if_primary_term"))
+	require.JSONEq(t, `{"policy": `+testISMPolicyJSON+`}`, string(recorded.Body))
+
+	require.Equal(t, "logs", resp.Response.ID)
+	require.Equal(t, uint64(7), resp.Response.SeqNo)
+	require.Equal(t, uint64(1), resp.Response.PrimaryTerm)
+	require.Equal(t, opensearchtools.NewISMPolicy("logs", "hot").AddStates(opensearchtools.NewISMState("hot")), resp.Response.Policy)
+}
+
+func TestExecutor_GetISMPolicy(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"_id": "logs",
+		"_version": 1,
+		"_seq_no": 3,
+		"_primary_term": 1,
+		"policy": {
+			"policy_id": "logs",
+			"description": "Rolls logs over daily and deletes them after 30 days",
+			"last_updated_time": 1658146048666,
+			"schema_version": 17,
+			"error_notification": null,
+			"default_state": "hot",
+			"states": [
+				{
+					"name": "hot",
+					"actions": [{
+						"retry": {"count": 3, "backoff": "exponential", "delay": "1m"},
+						"rollover": {"min_size": "50gb", "min_doc_count": 1000000, "min_index_age": "1d", "copy_alias": false}
+					}],
+					"transitions": [{"state_name": "warm", "conditions": {"min_rollover_age": "1h"}}]
+				},
+				{
+					"name": "warm",
+					"actions": [
+						{"replica_count": {"number_of_replicas": 0}},
+						{"timeout": "2h", "force_merge": {"max_num_segments": 1}},
+						{"read_only": {}}
+					],
+					"transitions": [{"state_name": "delete", "conditions": {"min_index_age": "30d"}}]
+				},
+				{"name": "delete", "actions": [{"delete": {}}], "transitions": []}
+			],
+			"ism_template": [{"index_patterns": ["logs-*"], "priority": 100, "last_updated_time": 1658146048666}]
+		}
+	}`)
+
+	resp, err := executor.GetISMPolicy(context.Background(), opensearchtools.NewGetISMPolicyRequest("logs"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_plugins/_ism/policies/logs", recorded.Path)
+
+	want := testISMPolicy()
+	want.States[1].Actions[2].Parameters = map[string]any{}
+	require.Nil(t, resp.Response.Error)
+	require.Equal(t, uint64(3), resp.Response.SeqNo)
+	require.Equal(t, want, resp.Response.Policy)
+}
+
+func TestExecutor_GetISMPolicyMissing(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusNotFound, `{
+		"error": {"type": "status_exception", "reason": "Policy not found"},
+		"status": 404
+	}`)
+
+	resp, err := executor.GetISMPolicy(context.Background(), opensearchtools.NewGetISMPolicyRequest("logs"))
+	require.Nil(t, err)
+	require.Nil(t, resp.Response.Policy)
+	require.Equal(t, "status_exception", resp.Response.Error.Type)
+}
+
+func TestExecutor_DeleteISMPolicy(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"_index": ".opendistro-ism-config", "_id": "logs", "_version": 2, "result": "deleted"}`)
+
+	resp, err := executor.DeleteISMPolicy(context.Background(), opensearchtools.NewDeleteISMPolicyRequest("logs"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, recorded.Method)
+	require.Equal(t, "/_plugins/_ism/policies/logs", recorded.Path)
+	require.Equal(t, "deleted", resp.Response.Result)
+}
+
+func TestExecutor_ISMIndices(t *testing.T) {
+	indicesResp := `{
+		"updated_indices": 1,
+		"failures": true,
+		"failed_indices": [{"index_name": "test_index2", "index_uuid": "uuid", "reason": "This index already has a policy, use the update policy API to update index policies"}]
+	}`
+
+	tests := []struct {
+		name     string
+		do       func(e *Executor) (opensearchtools.OpenSearchResponse[opensearchtools.ISMIndicesResponse], error)
+		wantPath string
+		wantBody string
+	}{
+		{
+			name: "Add",
+			do: func(e *Executor) (opensearchtools.OpenSearchResponse[opensearchtools.ISMIndicesResponse], error) {
+				return e.AddISMPolicy(context.Background(), opensearchtools.NewAddISMPolicyRequest("logs", testIndex1, testIndex2))
+			},
+			wantPath: "/_plugins/_ism/add/test_index,test_index2",
+			wantBody: `{"policy_id": "logs"}`,
+		},
+		{
+			name: "Remove",
+			do: func(e *Executor) (opensearchtools.OpenSearchResponse[opensearchtools.ISMIndicesResponse], error) {
+				return e.RemoveISMPolicy(context.Background(), opensearchtools.NewRemoveISMPolicyRequest(testIndex1, testIndex2))
+			},
+			wantPath: "/_plugins/_ism/remove/test_index,test_index2",
+		},
+		{
+			name: "Change",
+			do: func(e *Executor) (opensearchtools.OpenSearchResponse[opensearchtools.ISMIndicesResponse], error) {
+				return e.ChangeISMPolicy(context.Background(), opensearchtools.NewChangeISMPolicyRequest("logs_v2", testIndex1, testIndex2).
+					WithState("warm").
+					WithIncludeStates("hot"))
+			},
+			wantPath: "/_plugins/_ism/change_policy/test_index,test_index2",
+			wantBody: `{"policy_id": "logs_v2", "state": "warm", "include": [{"state": "hot"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor, recorded := newRecordingExecutor(t, http.StatusOK, indicesResp)
+
+			resp, err := tt.do(executor)
+			require.Nil(t, err)
+			require.Equal(t, http.MethodPost, recorded.Method)
+			require.Equal(t, tt.wantPath, recorded.Path)
+			if tt.wantBody == "" {
+				require.Empty(t, recorded.Body)
+			} else {
+				require.JSONEq(t, tt.wantBody, string(recorded.Body))
+			}
+
+			require.Equal(t, 1, resp.Response.UpdatedIndices)
+			require.Equal(t, []opensearchtools.ISMFailedIndex{{
+				Index:     testIndex2,
+				IndexUUID: "uuid",
+				Reason:    "This index already has a policy, use the update policy API to update index policies",
+			}}, resp.Response.FailedIndices)
+		})
+	}
+}
+
+func TestExecutor_ExplainISM(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"test_index": {
+			"index.plugins.index_state_management.policy_id": "logs",
+			"index.opendistro.index_state_management.policy_id": "logs",
+			"index": "test_index",
+			"index_uuid": "uuid",
+			"policy_id": "logs",
+			"policy_seq_no": 3,
+			"policy_primary_term": 1,
+			"rolled_over": false,
+			"index_creation_date": 1658146000000,
+			"state": {"name": "hot", "start_time": 1658146048666},
+			"action": {"name": "rollover", "start_time": 1658146049666, "index": 0, "failed": false, "consumed_retries": 1, "last_retry_time": 0},
+			"step": {"name": "attempt_rollover", "start_time": 1658146049666, "step_status": "condition_not_met"},
+			"retry_info": {"failed": false, "consumed_retries": 0},
+			"info": {"message": "Pending rollover of index [index=test_index]"},
+			"enabled": true
+		},
+		"test_index2": {
+			"index.plugins.index_state_management.policy_id": null,
+			"index.opendistro.index_state_management.policy_id": null,
+			"enabled": null
+		},
+		"total_managed_indices": 1
+	}`)
+
+	resp, err := executor.ExplainISM(context.Background(), opensearchtools.NewExplainISMRequest(testIndex1, testIndex2))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_plugins/_ism/explain/test_index,test_index2", recorded.Path)
+
+	require.Equal(t, 1, resp.Response.TotalManagedIndices)
+	require.Equal(t, map[string]*opensearchtools.ISMExplanation{
+		testIndex1: {
+			PolicyID:        "logs",
+			Enabled:         true,
+			State:           "hot",
+			StateStartTime:  time.UnixMilli(1658146048666),
+			Action:          "rollover",
+			ActionStartTime: time.UnixMilli(1658146049666),
+			ConsumedRetries: 1,
+			Step:            "attempt_rollover",
+			StepStatus:      "condition_not_met",
+			Info:            map[string]any{"message": "Pending rollover of index [index=test_index]"},
+		},
+		testIndex2: {},
+	}, resp.Response.Indices)
+}
+
+func TestExplainISMRequest_Validate(t *testing.T) {
+	req, _ := FromDomainExplainISMRequest(opensearchtools.NewExplainISMRequest())
+	vrs := req.Validate()
+	require.True(t, vrs.IsFatal())
+}
@@ -0,0 +1,357 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// Dynamic is the dynamic parameter of a mapping, which OpenSearch accepts either as a boolean or a string.
+type Dynamic opensearchtools.Dynamic
+
+// UnmarshalJSON decodes the dynamic parameter from either a boolean or a string.
+func (d *Dynamic) UnmarshalJSON(data []byte) error {
+	var dynamic string
+	if err := json.Unmarshal(data, &dynamic); err == nil {
+		*d = Dynamic(dynamic)
+		return nil
+	}
+
+	var enabled bool
+	if err := json.Unmarshal(data, &enabled); err != nil {
+		return err
+	}
+
+	*d = Dynamic(strconv.FormatBool(enabled))
+	return nil
+}
+
+// Mapping is the serializable form of an [opensearchtools.Mapping] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/field-types/index/
+type Mapping struct {
+	Dynamic    Dynamic              `json:"dynamic,omitempty"`
+	Properties map[string]*Property `json:"properties,omitempty"`
+}
+
+// Property is the serializable form of an [opensearchtools.Property] in OpenSearch V2.
+type Property struct {
+	Type           opensearchtools.FieldType `json:"type,omitempty"`
+	Analyzer       string                    `json:"analyzer,omitempty"`
+	SearchAnalyzer string                    `json:"search_analyzer,omitempty"`
+	Normalizer     string                    `json:"normalizer,omitempty"`
+	Index          *bool                     `json:"index,omitempty"`
+	DocValues      *bool                     `json:"doc_values,omitempty"`
+	IgnoreAbove    int                       `json:"ignore_above,omitempty"`
+	Format         string                    `json:"format,omitempty"`
+	ScalingFactor  float64                   `json:"scaling_factor,omitempty"`
+	Dynamic        Dynamic                   `json:"dynamic,omitempty"`
+	Properties     map[string]*Property      `json:"properties,omitempty"`
+	Fields         map[string]*Property      `json:"fields,omitempty"`
+	Dimension      int                       `json:"dimension,omitempty"`
+	Method         *KNNMethod                `json:"method,omitempty"`
+}
+
+// KNNMethod is the serializable form of an [opensearchtools.KNNMethod] in OpenSearch V2.
+type KNNMethod struct {
+	Name       string         `json:"name"`
+	SpaceType  string         `json:"space_type,omitempty"`
+	Engine     string         `json:"engine,omitempty"`
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// FromDomainMapping creates a new [Mapping] from the given [opensearchtools.Mapping].
+func FromDomainMapping(mapping *opensearchtools.Mapping) *Mapping {
+	if mapping == nil {
+		return nil
+	}
+
+	return &Mapping{
+		Dynamic:    Dynamic(mapping.Dynamic),
+		Properties: fromDomainProperties(mapping.Properties),
+	}
+}
+
+// fromDomainProperties converts the given [opensearchtools.Property]s by name
+func fromDomainProperties(properties map[string]*opensearchtools.Property) map[string]*Property {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	converted := make(map[string]*Property, len(properties))
+	for name, property := range properties {
+		if property == nil {
+			continue
+		}
+
+		converted[name] = fromDomainProperty(property)
+	}
+
+	return converted
+}
+
+// fromDomainProperty converts the given [opensearchtools.Property]
+func fromDomainProperty(property *opensearchtools.Property) *Property {
+	converted := &Property{
+		Type:           property.Type,
+		Analyzer:       property.Analyzer,
+		SearchAnalyzer: property.SearchAnalyzer,
+		Normalizer:     property.Normalizer,
+		Index:          property.Index,
+		DocValues:      property.DocValues,
+		IgnoreAbove:    property.IgnoreAbove,
+		Format:         property.Format,
+		ScalingFactor:  property.ScalingFactor,
+		Dynamic:        Dynamic(property.Dynamic),
+		Properties:     fromDomainProperties(property.Properties),
+		Fields:         fromDomainProperties(property.Fields),
+		Dimension:      property.Dimension,
+	}
+
+	if property.Method != nil {
+		converted.Method = &KNNMethod{
+			Name:       property.Method.Name,
+			SpaceType:  property.Method.SpaceType,
+			Engine:     property.Method.Engine,
+			Parameters: property.Method.Parameters,
+		}
+	}
+
+	return converted
+}
+
+// toDomain converts this instance of a [Mapping] into an [opensearchtools.Mapping].
+func (m *Mapping) toDomain() *opensearchtools.Mapping {
+	return &opensearchtools.Mapping{
+		Dynamic:    opensearchtools.Dynamic(m.Dynamic),
+		Properties: propertiesToDomain(m.Properties),
+	}
+}
+
+// propertiesToDomain converts the given [Property]s by name into [opensearchtools.Property]s
+func propertiesToDomain(properties map[string]*Property) map[string]*opensearchtools.Property {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	converted := make(map[string]*opensearchtools.Property, len(properties))
+	for name, property := range properties {
+		if property == nil {
+			continue
+		}
+
+		converted[name] = property.toDomain()
+	}
+
+	return converted
+}
+
+// toDomain converts this instance of a [Property] into an [opensearchtools.Property].
+// OpenSearch omits the type of object fields, it is set back to object.
+func (p *Property) toDomain() *opensearchtools.Property {
+	converted := &opensearchtools.Property{
+		Type:           p.Type,
+		Analyzer:       p.Analyzer,
+		SearchAnalyzer: p.SearchAnalyzer,
+		Normalizer:     p.Normalizer,
+		Index:          p.Index,
+		DocValues:      p.DocValues,
+		IgnoreAbove:    p.IgnoreAbove,
+		Format:         p.Format,
+		ScalingFactor:  p.ScalingFactor,
+		Dynamic:        opensearchtools.Dynamic(p.Dynamic),
+		Properties:     propertiesToDomain(p.Properties),
+		Fields:         propertiesToDomain(p.Fields),
+		Dimension:      p.Dimension,
+	}
+
+	if converted.Type == "" && len(converted.Properties) > 0 {
+		converted.Type = opensearchtools.FieldTypeObject
+	}
+
+	if p.Method != nil {
+		converted.Method = &opensearchtools.KNNMethod{
+			Name:       p.Method.Name,
+			SpaceType:  p.Method.SpaceType,
+			Engine:     p.Method.Engine,
+			Parameters: p.Method.Parameters,
+		}
+	}
+
+	return converted
+}
+
+// GetMappingRequest is a serializable form of [opensearchtools.GetMappingRequest] specific to
+// the [opensearchapi.IndicesGetMappingRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/field-types/index/#get-a-mapping
+type GetMappingRequest struct {
+	// Indices whose mapping to get, wildcards are supported. All indices if empty.
+	Indices []string
+}
+
+// FromDomainGetMappingRequest creates a new [GetMappingRequest] from the given [opensearchtools.GetMappingRequest].
+func FromDomainGetMappingRequest(req *opensearchtools.GetMappingRequest) (GetMappingRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetMappingRequest{
+		Indices: req.Indices,
+	}, vrs
+}
+
+// Do executes the [GetMappingRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetMappingResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetMappingRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetMappingResponse], error) {
+	osResp, rErr := opensearchapi.IndicesGetMappingRequest{
+		Index: r.Indices,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var mappingResp GetMappingResponse
+	if osResp.IsError() {
+		errResp, err := decodeResponse[errorResponse](osResp)
+		if err != nil {
+			return nil, err
+		}
+
+		mappingResp.Error = errResp.Error
+	} else {
+		indexMappings, err := decodeResponse[map[string]indexMapping](osResp)
+		if err != nil {
+			return nil, err
+		}
+
+		mappingResp.Mappings = make(map[string]*Mapping, len(indexMappings))
+		for index, indexMapping := range indexMappings {
+			mappingResp.Mappings[index] = indexMapping.Mappings
+		}
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		mappingResp,
+	)
+	return &resp, nil
+}
+
+// indexMapping is the mapping of an index in the response of a [GetMappingRequest]
+type indexMapping struct {
+	Mappings *Mapping `json:"mappings"`
+}
+
+// GetMappingResponse represents the response for a [GetMappingRequest].
+type GetMappingResponse struct {
+	Mappings map[string]*Mapping
+	Error    *Error
+}
+
+// toDomain converts this instance of a [GetMappingResponse] into an [opensearchtools.GetMappingResponse].
+func (r GetMappingResponse) toDomain() opensearchtools.GetMappingResponse {
+	var domainResp opensearchtools.GetMappingResponse
+
+	if r.Mappings != nil {
+		domainResp.Mappings = make(map[string]*opensearchtools.Mapping, len(r.Mappings))
+		for index, mapping := range r.Mappings {
+			if mapping == nil {
+				mapping = &Mapping{}
+			}
+
+			domainResp.Mappings[index] = mapping.toDomain()
+		}
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// PutMappingRequest is a serializable form of [opensearchtools.PutMappingRequest] specific to
+// the [opensearchapi.IndicesPutMappingRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/put-mapping/
+type PutMappingRequest struct {
+	// Indices whose mapping to update, wildcards are supported
+	Indices []string
+
+	// Mapping to merge into the existing mappings
+	Mapping *Mapping
+}
+
+// FromDomainPutMappingRequest creates a new [PutMappingRequest] from the given [opensearchtools.PutMappingRequest].
+func FromDomainPutMappingRequest(req *opensearchtools.PutMappingRequest) (PutMappingRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return PutMappingRequest{
+		Indices: req.Indices,
+		Mapping: FromDomainMapping(req.Mapping),
+	}, vrs
+}
+
+// Validate validates the given PutMappingRequest
+func (r *PutMappingRequest) Validate() opensearchtools.ValidationResults {
+	validationResults := validateIndices("PutMappingRequest", r.Indices)
+
+	if r.Mapping == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Mapping not set on the PutMappingRequest", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the PutMappingRequest into the JSON shape expected by OpenSearch.
+func (r *PutMappingRequest) ToOpenSearchJSON() ([]byte, error) {
+	return json.Marshal(r.Mapping)
+}
+
+// Do executes the [PutMappingRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *PutMappingRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osResp, rErr := opensearchapi.IndicesPutMappingRequest{
+		Index: r.Indices,
+		Body:  bytes.NewReader(bodyBytes),
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
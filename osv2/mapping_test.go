@@ -0,0 +1,176 @@
+package osv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// testMappingJSON is the OpenSearch JSON of testMapping
+const testMappingJSON = `{
+	"dynamic": "strict",
+	"properties": {
+		"title": {
+			"type": "text",
+			"analyzer": "english",
+			"search_analyzer": "standard",
+			"fields": {"raw": {"type": "keyword", "ignore_above": 256, "normalizer": "lowercase"}}
+		},
+		"created": {"type": "date", "format": "strict_date_optional_time"},
+		"price": {"type": "scaled_float", "scaling_factor": 100},
+		"hidden": {"type": "keyword", "index": false, "doc_values": false},
+		"location": {"type": "geo_point"},
+		"author": {
+			"type": "object",
+			"dynamic": "false",
+			"properties": {"name": {"type": "keyword"}}
+		},
+		"comments": {
+			"type": "nested",
+			"properties": {"count": {"type": "long"}}
+		},
+		"embedding": {
+			"type": "knn_vector",
+			"dimension": 3,
+			"method": {"name": "hnsw", "space_type": "l2", "engine": "nmslib", "parameters": {"m": 16}}
+		}
+	}
+}`
+
+// testMapping builds a mapping with every supported kind of property
+func testMapping() *opensearchtools.Mapping {
+	return opensearchtools.NewMapping().
+		WithDynamic(opensearchtools.DynamicStrict).
+		AddProperty("title", opensearchtools.NewTextProperty().
+			WithAnalyzer("english").
+			WithSearchAnalyzer("standard").
+			AddField("raw", opensearchtools.NewKeywordProperty().WithIgnoreAbove(256).WithNormalizer("lowercase"))).
+		AddProperty("created", opensearchtools.NewDateProperty().WithFormat("strict_date_optional_time")).
+		AddProperty("price", opensearchtools.NewProperty(opensearchtools.FieldTypeScaledFloat).WithScalingFactor(100)).
+		AddProperty("hidden", opensearchtools.NewKeywordProperty().WithIndex(false).WithDocValues(false)).
+		AddProperty("location", opensearchtools.NewGeoPointProperty()).
+		AddProperty("author", opensearchtools.NewObjectProperty().
+			WithDynamic(opensearchtools.DynamicFalse).
+			AddProperty("name", opensearchtools.NewKeywordProperty())).
+		AddProperty("comments", opensearchtools.NewNestedProperty().
+			AddProperty("count", opensearchtools.NewProperty(opensearchtools.FieldTypeLong))).
+		AddProperty("embedding", opensearchtools.NewKNNVectorProperty(3).
+			WithMethod(opensearchtools.NewKNNMethod("hnsw", "l2", "nmslib").WithParameters(map[string]any{"m": float64(16)})))
+}
+
+func TestMapping_JSON(t *testing.T) {
+	got, err := json.Marshal(FromDomainMapping(testMapping()))
+	require.Nil(t, err)
+	require.JSONEq(t, testMappingJSON, string(got))
+
+	var decoded Mapping
+	require.Nil(t, json.Unmarshal([]byte(testMappingJSON), &decoded))
+	require.Equal(t, testMapping(), decoded.toDomain())
+}
+
+func TestMapping_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		wantDynamic opensearchtools.Dynamic
+		wantType    opensearchtools.FieldType
+	}{
+		{
+			name:        "Boolean dynamic",
+			json:        `{"dynamic": false, "properties": {"field": {"properties": {"sub_field": {"type": "keyword"}}}}}`,
+			wantDynamic: opensearchtools.DynamicFalse,
+			wantType:    opensearchtools.FieldTypeObject,
+		},
+		{
+			name:        "String dynamic",
+			json:        `{"dynamic": "true", "properties": {"field": {"type": "keyword"}}}`,
+			wantDynamic: opensearchtools.DynamicTrue,
+			wantType:    opensearchtools.FieldTypeKeyword,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded Mapping
+			require.Nil(t, json.Unmarshal([]byte(tt.json), &decoded))
+
+			mapping := decoded.toDomain()
+			require.Equal(t, tt.wantDynamic, mapping.Dynamic)
+			require.Equal(t, tt.wantType, mapping.Properties["field"].Type)
+		})
+	}
+}
+
+func TestExecutor_GetMapping(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"test_index": {"mappings": `+testMappingJSON+`}, "test_index2": {"mappings": {}}}`)
+
+	resp, err := executor.GetMapping(context.Background(), opensearchtools.NewGetMappingRequest(testIndex1, testIndex2))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/test_index,test_index2/_mapping", recorded.Path)
+
+	require.Nil(t, resp.Response.Error)
+	require.Equal(t, map[string]*opensearchtools.Mapping{
+		testIndex1: testMapping(),
+		testIndex2: opensearchtools.NewMapping(),
+	}, resp.Response.Mappings)
+}
+
+func TestExecutor_GetMappingMissingIndex(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusNotFound, `{
+		"error": {"type": "index_not_found_exception", "reason": "no such index [test_index]", "index": "test_index"},
+		"status": 404
+	}`)
+
+	resp, err := executor.GetMapping(context.Background(), opensearchtools.NewGetMappingRequest(testIndex1))
+	require.Nil(t, err)
+	require.Nil(t, resp.Response.Mappings)
+	require.Equal(t, "index_not_found_exception", resp.Response.Error.Type)
+}
+
+func TestExecutor_PutMapping(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	mapping := opensearchtools.NewMapping().AddProperty("new_field", opensearchtools.NewKeywordProperty())
+	resp, err := executor.PutMapping(context.Background(), opensearchtools.NewPutMappingRequest(mapping, testIndex1))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, recorded.Method)
+	require.Equal(t, "/test_index/_mapping", recorded.Path)
+	require.JSONEq(t, `{"properties": {"new_field": {"type": "keyword"}}}`, string(recorded.Body))
+	require.True(t, resp.Response.Acknowledged)
+}
+
+func TestPutMappingRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.PutMappingRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewPutMappingRequest(opensearchtools.NewMapping(), testIndex1),
+		},
+		{
+			name:      "Missing indices",
+			request:   opensearchtools.NewPutMappingRequest(opensearchtools.NewMapping()),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing mapping",
+			request:   opensearchtools.NewPutMappingRequest(nil, testIndex1),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainPutMappingRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// MSearchRequest is a serializable form of [opensearchtools.MSearchRequest] specific to the
+// [opensearchapi.MsearchRequest] in OpenSearch V2.
+// Each search is encoded as a header and body pair of newline delimited JSON.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/multi-search/
+type MSearchRequest struct {
+	// Searches to be executed
+	Searches []SearchRequest
+
+	// MaxConcurrentSearches limits how many searches are executed concurrently. Omitted if zero.
+	MaxConcurrentSearches int
+}
+
+// NewMSearchRequest instantiates an empty MSearchRequest.
+func NewMSearchRequest() *MSearchRequest {
+	return &MSearchRequest{}
+}
+
+// Add a [SearchRequest] to the searches to be executed.
+func (r *MSearchRequest) Add(searches ...SearchRequest) *MSearchRequest {
+	r.Searches = append(r.Searches, searches...)
+	return r
+}
+
+// WithMaxConcurrentSearches limits how many searches OpenSearch executes concurrently.
+func (r *MSearchRequest) WithMaxConcurrentSearches(n int) *MSearchRequest {
+	r.MaxConcurrentSearches = n
+	return r
+}
+
+// FromDomainMSearchRequest creates a new [MSearchRequest] from the given [opensearchtools.MSearchRequest].
+// Each search is converted with [FromDomainSearchRequest].
+func FromDomainMSearchRequest(req *opensearchtools.MSearchRequest) (MSearchRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	msearchReq := MSearchRequest{
+		Searches:              make([]SearchRequest, 0, len(req.Searches)),
+		MaxConcurrentSearches: req.MaxConcurrentSearches,
+	}
+
+	for _, search := range req.Searches {
+		searchReq, searchVrs := FromDomainSearchRequest(search)
+		vrs.Extend(searchVrs)
+		msearchReq.Searches = append(msearchReq.Searches, searchReq)
+	}
+
+	return msearchReq, vrs
+}
+
+// Validate validates the given MSearchRequest and each of its searches
+func (r *MSearchRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(r.Searches) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("MSearchRequest requires at least one search", true))
+	}
+
+	if r.MaxConcurrentSearches < 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("MaxConcurrentSearches cannot be negative", true))
+	}
+
+	for i := range r.Searches {
+		validationResults.Extend(r.Searches[i].Validate())
+
+		if r.Searches[i].Scroll > 0 {
+			validationResults.Add(opensearchtools.NewValidationResult("Scroll cannot be used in a MSearchRequest", true))
+		}
+	}
+
+	return validationResults
+}
+
+// ToNDJSON encodes the searches as the header and body pairs of newline delimited JSON expected by OpenSearch.
+func (r *MSearchRequest) ToNDJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+
+	for i := range r.Searches {
+		search := &r.Searches[i]
+
+		header := make(map[string]any)
+		// a PIT is bound to its indices, which must not be part of the header
+		if len(search.Index) > 0 && search.PointInTime == nil {
+			header["index"] = search.Index
+		}
+
+		if len(search.Routing) > 0 {
+			header["routing"] = strings.Join(search.Routing, ",")
+		}
+
+		body, err := search.source()
+		if err != nil {
+			return nil, err
+		}
+
+		if search.TrackTotalHits != nil {
+			body["track_total_hits"] = search.TrackTotalHits
+		}
+
+		// Encode terminates each line with the newline separating it from the next
+		if err := encoder.Encode(header); err != nil {
+			return nil, err
+		}
+
+		if err := encoder.Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Do executes the [MSearchRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [MSearchResponse] will be returned,
+// with a result for each search in the same order.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - A search cannot be encoded
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *MSearchRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[MSearchResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToNDJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.MsearchRequest{
+		Body: bytes.NewReader(bodyBytes),
+	}
+
+	if r.MaxConcurrentSearches > 0 {
+		osReq.MaxConcurrentSearches = &r.MaxConcurrentSearches
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+	defer osResp.Body.Close()
+
+	var respBuf bytes.Buffer
+	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
+		return nil, err
+	}
+
+	var msearchResp MSearchResponse
+	decoder := json.NewDecoder(&respBuf)
+	// numbers are kept as json.Number like in a SearchResponse, to preserve the precision of sort values
+	decoder.UseNumber()
+	if err := decoder.Decode(&msearchResp); err != nil {
+		return nil, err
+	}
+
+	if msearchResp.Error == nil && len(msearchResp.Responses) != len(r.Searches) {
+		return nil, fmt.Errorf("msearch returned %d responses for %d searches", len(msearchResp.Responses), len(r.Searches))
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		msearchResp,
+	)
+	return &resp, nil
+}
+
+// MSearchResponse represents the response for a [MSearchRequest].
+// Error is set if the whole request failed.
+type MSearchResponse struct {
+	Took      int             `json:"took"`
+	Responses []MSearchResult `json:"responses"`
+	Error     *Error          `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [MSearchResponse] into an [opensearchtools.MSearchResponse].
+func (r *MSearchResponse) toDomain() opensearchtools.MSearchResponse {
+	domainResp := opensearchtools.MSearchResponse{
+		Took:      r.Took,
+		Responses: make([]opensearchtools.MSearchResult, len(r.Responses)),
+	}
+
+	for i := range r.Responses {
+		domainResp.Responses[i] = r.Responses[i].toDomain()
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// MSearchResult represents the result of an individual search of a [MSearchResponse].
+// A failed search only has an Error and Status.
+type MSearchResult struct {
+	SearchResponse
+	Status int `json:"status"`
+}
+
+// toDomain converts this instance of a [MSearchResult] into an [opensearchtools.MSearchResult].
+func (r *MSearchResult) toDomain() opensearchtools.MSearchResult {
+	domainResult := opensearchtools.MSearchResult{
+		Status: r.Status,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResult.Error = &domainErr
+		return domainResult
+	}
+
+	searchResp := r.SearchResponse.toDomain()
+	domainResult.Response = &searchResp
+
+	return domainResult
+}
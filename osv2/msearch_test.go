@@ -0,0 +1,112 @@
+package osv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestMSearchRequest_ToNDJSON(t *testing.T) {
+	req := NewMSearchRequest().Add(
+		*NewSearchRequest().
+			AddIndices(testIndex1, testIndex2).
+			WithRouting("a", "b").
+			WithSize(5).
+			WithTrackTotalHits(true),
+		*NewSearchRequest().
+			AddIndices(testIndex1).
+			WithPointInTime("pit_1", time.Minute),
+	)
+
+	got, err := req.ToNDJSON()
+	require.Nil(t, err)
+
+	want := `{"index":["test_index","test_index2"],"routing":"a,b"}
+{"size":5,"track_total_hits":true}
+{}
+{"pit":{"id":"pit_1","keep_alive":"60000ms"}}
+`
+	require.Equal(t, want, string(got))
+}
+
+func TestMSearchRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.MSearchRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewMSearchRequest(opensearchtools.NewSearchRequest()),
+		},
+		{
+			name:      "No searches",
+			request:   opensearchtools.NewMSearchRequest(),
+			wantFatal: true,
+		},
+		{
+			name:      "Invalid search",
+			request:   opensearchtools.NewMSearchRequest(opensearchtools.NewSearchRequest().WithSearchAfter(10)),
+			wantFatal: true,
+		},
+		{
+			name:      "Scroll search",
+			request:   opensearchtools.NewMSearchRequest(opensearchtools.NewSearchRequest().WithScroll(time.Minute)),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainMSearchRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_MSearch(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"took": 5, "responses": [
+		{"took": 2, "hits": {"hits": [{"_index": "test_index", "_id": "test_id", "sort": [7]}]}, "status": 200},
+		{"error": {"type": "index_not_found_exception", "reason": "no such index [test_index2]"}, "status": 404}
+	]}`)
+
+	req := opensearchtools.NewMSearchRequest().
+		Add(opensearchtools.NewSearchRequest().AddIndices(testIndex1)).
+		Add(opensearchtools.NewSearchRequest().AddIndices(testIndex2)).
+		WithMaxConcurrentSearches(2)
+
+	resp, err := executor.MSearch(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/_msearch", recorded.Path)
+	require.Equal(t, "2", recorded.Query.Get("max_concurrent_searches"))
+	require.Equal(t, "{\"index\":[\"test_index\"]}\n{}\n{\"index\":[\"test_index2\"]}\n{}\n", string(recorded.Body))
+
+	require.Equal(t, 5, resp.Response.Took)
+	require.Len(t, resp.Response.Responses, 2)
+
+	found := resp.Response.Responses[0]
+	require.False(t, found.Failed())
+	require.Equal(t, http.StatusOK, found.Status)
+	require.Equal(t, 2, found.Response.Took)
+	require.Equal(t, []opensearchtools.Hit{{Index: testIndex1, ID: testID1, Sort: []any{json.Number("7")}}}, found.Response.Hits.Hits)
+
+	notFound := resp.Response.Responses[1]
+	require.True(t, notFound.Failed())
+	require.Equal(t, http.StatusNotFound, notFound.Status)
+	require.Nil(t, notFound.Response)
+	require.Equal(t, "index_not_found_exception", notFound.Error.Type)
+}
+
+func TestExecutor_MSearchMismatchedResponses(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusOK, `{"took": 5, "responses": []}`)
+
+	_, err := executor.MSearch(context.Background(), opensearchtools.NewMSearchRequest(opensearchtools.NewSearchRequest()))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,338 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// CreatePITRequest is a serializable form of [opensearchtools.CreatePITRequest] specific to OpenSearch V2.
+// Point-in-time is supported by OpenSearch 2.4 and later.
+//
+// For more details see https://opensearch.org/docs/latest/search-plugins/point-in-time-api/#create-a-pit
+type CreatePITRequest struct {
+	// Indices the PIT is created for
+	Indices []string
+
+	// KeepAlive is how long the PIT is kept alive
+	KeepAlive time.Duration
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+
+	// Preference of the nodes or shards the request is performed on
+	Preference string
+
+	// ExpandWildcards determines the types of indices wildcard expressions can match, such as "open" or "all"
+	ExpandWildcards string
+
+	// AllowPartialPITCreation determines if a PIT is created when some of the shards are unavailable
+	AllowPartialPITCreation *bool
+}
+
+// FromDomainCreatePITRequest creates a new [CreatePITRequest] from the given [opensearchtools.CreatePITRequest].
+func FromDomainCreatePITRequest(req *opensearchtools.CreatePITRequest) (CreatePITRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return CreatePITRequest{
+		Indices:                 req.Indices,
+		KeepAlive:               req.KeepAlive,
+		Routing:                 req.Routing,
+		Preference:              req.Preference,
+		ExpandWildcards:         req.ExpandWildcards,
+		AllowPartialPITCreation: req.AllowPartialPITCreation,
+	}, vrs
+}
+
+// Validate validates the given CreatePITRequest
+func (r *CreatePITRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(r.Indices) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("CreatePITRequest requires at least one index", true))
+	}
+
+	if r.KeepAlive <= 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("CreatePITRequest requires a positive KeepAlive", true))
+	}
+
+	return validationResults
+}
+
+// params builds the query parameters of the request
+func (r *CreatePITRequest) params() url.Values {
+	params := url.Values{}
+	params.Set("keep_alive", formatDuration(r.KeepAlive))
+
+	if r.Routing != "" {
+		params.Set("routing", r.Routing)
+	}
+
+	if r.Preference != "" {
+		params.Set("preference", r.Preference)
+	}
+
+	if r.ExpandWildcards != "" {
+		params.Set("expand_wildcards", r.ExpandWildcards)
+	}
+
+	if r.AllowPartialPITCreation != nil {
+		params.Set("allow_partial_pit_creation", strconv.FormatBool(*r.AllowPartialPITCreation))
+	}
+
+	return params
+}
+
+// Do executes the [CreatePITRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [CreatePITResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *CreatePITRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[CreatePITResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodPost,
+		[]string{strings.Join(r.Indices, ","), "_search", "point_in_time"}, r.params(), nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	pitResp, err := decodeResponse[CreatePITResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		pitResp,
+	)
+	return &resp, nil
+}
+
+// CreatePITResponse represents the response for a [CreatePITRequest].
+type CreatePITResponse struct {
+	PITID        string    `json:"pit_id"`
+	Shards       ShardMeta `json:"_shards"`
+	CreationTime int64     `json:"creation_time"`
+	Error        *Error    `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [CreatePITResponse] into an [opensearchtools.CreatePITResponse].
+func (r CreatePITResponse) toDomain() opensearchtools.CreatePITResponse {
+	domainResp := opensearchtools.CreatePITResponse{
+		PITID:        r.PITID,
+		Shards:       r.Shards.toDomain(),
+		CreationTime: r.CreationTime,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// DeletePITRequest is a serializable form of [opensearchtools.DeletePITRequest] specific to OpenSearch V2.
+// Either PITIDs or All must be set.
+//
+// For more details see https://opensearch.org/docs/latest/search-plugins/point-in-time-api/#delete-pits
+type DeletePITRequest struct {
+	// PITIDs to be deleted
+	PITIDs []string
+
+	// All deletes every PIT of the cluster, PITIDs are ignored
+	All bool
+}
+
+// FromDomainDeletePITRequest creates a new [DeletePITRequest] from the given [opensearchtools.DeletePITRequest].
+func FromDomainDeletePITRequest(req *opensearchtools.DeletePITRequest) (DeletePITRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DeletePITRequest{
+		PITIDs: req.PITIDs,
+		All:    req.All,
+	}, vrs
+}
+
+// Validate validates the given DeletePITRequest
+func (r *DeletePITRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if !r.All && len(r.PITIDs) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("DeletePITRequest requires at least one PIT ID or All", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the DeletePITRequest into the JSON shape expected by OpenSearch.
+// A request deleting all PITs has no body.
+func (r *DeletePITRequest) ToOpenSearchJSON() ([]byte, error) {
+	if r.All {
+		return nil, nil
+	}
+
+	return json.Marshal(map[string]any{"pit_id": r.PITIDs})
+}
+
+// Do executes the [DeletePITRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [DeletePITResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeletePITRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[DeletePITResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	pathSegments := []string{"_search", "point_in_time"}
+	var body io.Reader
+	if r.All {
+		pathSegments = append(pathSegments, "_all")
+	} else {
+		bodyBytes, jErr := r.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		body = bytes.NewReader(bodyBytes)
+	}
+
+	osResp, rErr := doRequest(ctx, client, http.MethodDelete, pathSegments, nil, body)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	pitResp, err := decodeResponse[DeletePITResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		pitResp,
+	)
+	return &resp, nil
+}
+
+// DeletePITResponse represents the response for a [DeletePITRequest].
+type DeletePITResponse struct {
+	PITs  []DeletedPIT `json:"pits"`
+	Error *Error       `json:"error,omitempty"`
+}
+
+// DeletedPIT is the outcome of the deletion of a single PIT.
+type DeletedPIT struct {
+	PITID      string `json:"pit_id"`
+	Successful bool   `json:"successful"`
+}
+
+// toDomain converts this instance of a [DeletePITResponse] into an [opensearchtools.DeletePITResponse].
+func (r DeletePITResponse) toDomain() opensearchtools.DeletePITResponse {
+	var domainResp opensearchtools.DeletePITResponse
+	for _, pit := range r.PITs {
+		domainResp.PITs = append(domainResp.PITs, opensearchtools.DeletedPIT{
+			PITID:      pit.PITID,
+			Successful: pit.Successful,
+		})
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// ListPITsRequest lists all the PITs of the cluster.
+//
+// For more details see https://opensearch.org/docs/latest/search-plugins/point-in-time-api/#list-all-pits
+type ListPITsRequest struct{}
+
+// Do executes the [ListPITsRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [ListPITsResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *ListPITsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ListPITsResponse], error) {
+	osResp, rErr := doRequest(ctx, client, http.MethodGet, []string{"_search", "point_in_time", "_all"}, nil, nil)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	pitResp, err := decodeResponse[ListPITsResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		pitResp,
+	)
+	return &resp, nil
+}
+
+// ListPITsResponse represents the response for a [ListPITsRequest].
+type ListPITsResponse struct {
+	PITs  []PITInfo `json:"pits"`
+	Error *Error    `json:"error,omitempty"`
+}
+
+// PITInfo describes an existing PIT.
+type PITInfo struct {
+	PITID        string `json:"pit_id"`
+	CreationTime int64  `json:"creation_time"`
+
+	// KeepAlive in milliseconds
+	KeepAlive int64 `json:"keep_alive"`
+}
+
+// toDomain converts this instance of a [ListPITsResponse] into an [opensearchtools.ListPITsResponse].
+func (r ListPITsResponse) toDomain() opensearchtools.ListPITsResponse {
+	var domainResp opensearchtools.ListPITsResponse
+	for _, pit := range r.PITs {
+		domainResp.PITs = append(domainResp.PITs, opensearchtools.PITInfo{
+			PITID:        pit.PITID,
+			CreationTime: pit.CreationTime,
+			KeepAlive:    time.Duration(pit.KeepAlive) * time.Millisecond,
+		})
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
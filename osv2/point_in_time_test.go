@@ -0,0 +1,126 @@
+package osv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestCreatePITRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.CreatePITRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewCreatePITRequest(time.Minute, testIndex1),
+		},
+		{
+			name:      "Missing indices",
+			request:   opensearchtools.NewCreatePITRequest(time.Minute),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing keep alive",
+			request:   opensearchtools.NewCreatePITRequest(0, testIndex1),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainCreatePITRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_CreatePIT(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"pit_id": "pit_1", "_shards": {"total": 2, "successful": 2}, "creation_time": 1658146050064}`)
+
+	req := opensearchtools.NewCreatePITRequest(time.Minute, testIndex1, testIndex2).
+		WithRouting("route").
+		WithAllowPartialPITCreation(false)
+
+	resp, err := executor.CreatePIT(context.Background(), req)
+	require.Nil(t, err)
+
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/test_index,test_index2/_search/point_in_time", recorded.Path)
+	require.Equal(t, "60000ms", recorded.Query.Get("keep_alive"))
+	require.Equal(t, "route", recorded.Query.Get("routing"))
+	require.Equal(t, "false", recorded.Query.Get("allow_partial_pit_creation"))
+
+	require.Equal(t, opensearchtools.CreatePITResponse{
+		PITID:        "pit_1",
+		Shards:       opensearchtools.ShardMeta{Total: 2, Successful: 2},
+		CreationTime: 1658146050064,
+	}, resp.Response)
+}
+
+func TestExecutor_DeletePIT(t *testing.T) {
+	pitsResp := `{"pits": [{"successful": true, "pit_id": "pit_1"}, {"successful": false, "pit_id": "pit_2"}]}`
+	wantResp := opensearchtools.DeletePITResponse{PITs: []opensearchtools.DeletedPIT{
+		{PITID: "pit_1", Successful: true},
+		{PITID: "pit_2", Successful: false},
+	}}
+
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, pitsResp)
+	resp, err := executor.DeletePIT(context.Background(), opensearchtools.NewDeletePITRequest("pit_1", "pit_2"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, recorded.Method)
+	require.Equal(t, "/_search/point_in_time", recorded.Path)
+	require.JSONEq(t, `{"pit_id": ["pit_1", "pit_2"]}`, string(recorded.Body))
+	require.Equal(t, wantResp, resp.Response)
+
+	resp, err = executor.DeletePIT(context.Background(), opensearchtools.NewDeleteAllPITsRequest())
+	require.Nil(t, err)
+	require.Equal(t, "/_search/point_in_time/_all", recorded.Path)
+	require.Len(t, recorded.Body, 0)
+	require.Equal(t, wantResp, resp.Response)
+
+	_, err = executor.DeletePIT(context.Background(), opensearchtools.NewDeletePITRequest())
+	require.NotNil(t, err)
+}
+
+func TestExecutor_ListPITs(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"pits": [{"pit_id": "pit_1", "creation_time": 1658146048666, "keep_alive": 6000000}]}`)
+
+	resp, err := executor.ListPITs(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_search/point_in_time/_all", recorded.Path)
+	require.Equal(t, opensearchtools.ListPITsResponse{PITs: []opensearchtools.PITInfo{{
+		PITID:        "pit_1",
+		CreationTime: 1658146048666,
+		KeepAlive:    100 * time.Minute,
+	}}}, resp.Response)
+}
+
+func TestExecutor_SearchPointInTime(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"took": 1, "pit_id": "pit_2", "hits": {"hits": [{"_id": "test_id", "sort": [1658146048666, 7]}]}}`)
+
+	req := opensearchtools.NewSearchRequest().
+		AddIndices(testIndex1).
+		AddSorts(opensearchtools.NewSort("timestamp", false)).
+		WithPointInTime("pit_1", time.Minute)
+
+	resp, err := executor.Search(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, "/_search", recorded.Path)
+	require.JSONEq(t, `{"pit": {"id": "pit_1", "keep_alive": "60000ms"}, "sort": [{"timestamp": {"order": "asc"}}]}`, string(recorded.Body))
+	require.Equal(t, 1, resp.ValidationResults.Len())
+
+	require.Equal(t, "pit_2", resp.Response.PITID)
+	require.Equal(t, []any{json.Number("1658146048666"), json.Number("7")}, resp.Response.Hits.Hits[0].Sort)
+}
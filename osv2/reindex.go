@@ -0,0 +1,267 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// ReindexRequest is a serializable form of [opensearchtools.ReindexRequest] specific to
+// the [opensearchapi.ReindexRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/document-apis/reindex/
+type ReindexRequest struct {
+	// Source of the documents to copy
+	Source opensearchtools.ReindexSource
+
+	// Dest is the destination of the documents
+	Dest opensearchtools.ReindexDest
+
+	// Script transforming each document before it is written to the destination
+	Script *opensearchtools.Script
+
+	// Conflicts determines what happens on a version conflict, abort if empty
+	Conflicts opensearchtools.Conflicts
+
+	// Slices splits the operation into parallel sub-tasks, or [opensearchtools.SlicesAuto]. Omitted if zero.
+	Slices int
+
+	// RequestsPerSecond throttles the operation, -1 disables throttling. Omitted if zero.
+	RequestsPerSecond int
+
+	// MaxDocs is the maximum number of documents to copy. Omitted if zero.
+	MaxDocs int
+
+	// Refresh the destination once the operation completes
+	Refresh bool
+
+	// WaitForCompletion - when false, the operation runs as a task whose ID is returned. Defaults to true if nil.
+	WaitForCompletion *bool
+}
+
+// FromDomainReindexRequest creates a new [ReindexRequest] from the given [opensearchtools.ReindexRequest].
+// The source query is converted with [V2QueryConverter].
+func FromDomainReindexRequest(req *opensearchtools.ReindexRequest) (ReindexRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	reindexRequest := ReindexRequest{
+		Source:            req.Source,
+		Dest:              req.Dest,
+		Script:            req.Script,
+		Conflicts:         req.Conflicts,
+		Slices:            req.Slices,
+		RequestsPerSecond: req.RequestsPerSecond,
+		MaxDocs:           req.MaxDocs,
+		Refresh:           req.Refresh,
+		WaitForCompletion: req.WaitForCompletion,
+	}
+
+	if req.Source.Query != nil {
+		query, cErr := V2QueryConverter(req.Source.Query)
+		if cErr != nil {
+			vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+			return reindexRequest, vrs
+		}
+
+		reindexRequest.Source.Query = query
+	}
+
+	return reindexRequest, vrs
+}
+
+// Validate validates the given ReindexRequest
+func (r *ReindexRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(r.Source.Index) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("Source index not set on the ReindexRequest", true))
+	}
+
+	if r.Dest.Index == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Dest index not set on the ReindexRequest", true))
+	}
+
+	switch r.Dest.OpType {
+	case "", opensearchtools.OpTypeIndex, opensearchtools.OpTypeCreate:
+	default:
+		validationResults.Add(opensearchtools.NewValidationResult("Dest OpType must be index or create on the ReindexRequest", true))
+	}
+
+	if r.Source.Remote != nil {
+		if r.Source.Remote.Host == "" {
+			validationResults.Add(opensearchtools.NewValidationResult("Remote host not set on the ReindexRequest", true))
+		}
+
+		if r.Slices != 0 {
+			validationResults.Add(opensearchtools.NewValidationResult("Slices cannot be used when reindexing from a remote cluster", true))
+		}
+	}
+
+	if r.Slices < 0 && r.Slices != opensearchtools.SlicesAuto {
+		validationResults.Add(opensearchtools.NewValidationResult("Slices must be positive or SlicesAuto on the ReindexRequest", true))
+	}
+
+	if r.MaxDocs < 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("MaxDocs cannot be negative on the ReindexRequest", true))
+	}
+
+	if r.Script != nil {
+		validationResults.Extend(r.Script.Validate())
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the ReindexRequest into the JSON shape expected by OpenSearch.
+func (r *ReindexRequest) ToOpenSearchJSON() ([]byte, error) {
+	source := map[string]any{
+		"index": r.Source.Index,
+	}
+
+	if r.Source.Query != nil {
+		queryJSON, jErr := r.Source.Query.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		source["query"] = json.RawMessage(queryJSON)
+	}
+
+	if r.Source.Size > 0 {
+		source["size"] = r.Source.Size
+	}
+
+	if len(r.Source.Sort) > 0 {
+		sorts := make([]json.RawMessage, len(r.Source.Sort))
+		for i, s := range r.Source.Sort {
+			sortJSON, jErr := s.ToOpenSearchJSON()
+			if jErr != nil {
+				return nil, jErr
+			}
+
+			sorts[i] = sortJSON
+		}
+
+		source["sort"] = sorts
+	}
+
+	if remote := r.Source.Remote; remote != nil {
+		remoteSource := map[string]any{
+			"host": remote.Host,
+		}
+
+		if remote.Username != "" {
+			remoteSource["username"] = remote.Username
+			remoteSource["password"] = remote.Password
+		}
+
+		if len(remote.Headers) > 0 {
+			remoteSource["headers"] = remote.Headers
+		}
+
+		if remote.SocketTimeout > 0 {
+			remoteSource["socket_timeout"] = formatDuration(remote.SocketTimeout)
+		}
+
+		if remote.ConnectTimeout > 0 {
+			remoteSource["connect_timeout"] = formatDuration(remote.ConnectTimeout)
+		}
+
+		source["remote"] = remoteSource
+	}
+
+	dest := map[string]any{
+		"index": r.Dest.Index,
+	}
+
+	if r.Dest.OpType != "" {
+		dest["op_type"] = r.Dest.OpType
+	}
+
+	if r.Dest.Pipeline != "" {
+		dest["pipeline"] = r.Dest.Pipeline
+	}
+
+	body := map[string]any{
+		"source": source,
+		"dest":   dest,
+	}
+
+	if r.Conflicts != "" {
+		body["conflicts"] = r.Conflicts
+	}
+
+	if r.Script != nil {
+		scriptJSON, jErr := r.Script.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		body["script"] = json.RawMessage(scriptJSON)
+	}
+
+	return json.Marshal(body)
+}
+
+// Do executes the [ReindexRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [BulkByScrollResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *ReindexRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[BulkByScrollResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.ReindexRequest{
+		Body:              bytes.NewReader(bodyBytes),
+		Slices:            slicesParam(r.Slices),
+		WaitForCompletion: r.WaitForCompletion,
+	}
+
+	if r.RequestsPerSecond != 0 {
+		requestsPerSecond := r.RequestsPerSecond
+		osReq.RequestsPerSecond = &requestsPerSecond
+	}
+
+	if r.MaxDocs > 0 {
+		maxDocs := r.MaxDocs
+		osReq.MaxDocs = &maxDocs
+	}
+
+	if r.Refresh {
+		refresh := r.Refresh
+		osReq.Refresh = &refresh
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	reindexResp, err := decodeResponse[BulkByScrollResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		reindexResp,
+	)
+	return &resp, nil
+}
@@ -0,0 +1,158 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestReindexRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.ReindexRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewReindexRequest(testIndex2, testIndex1).WithSlices(opensearchtools.SlicesAuto),
+		},
+		{
+			name:      "Missing source",
+			request:   opensearchtools.NewReindexRequest(testIndex2),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing dest",
+			request:   opensearchtools.NewReindexRequest("", testIndex1),
+			wantFatal: true,
+		},
+		{
+			name:      "Invalid op type",
+			request:   opensearchtools.NewReindexRequest(testIndex2, testIndex1).WithOpType("delete"),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing remote host",
+			request:   opensearchtools.NewReindexRequest(testIndex2, testIndex1).WithRemote(opensearchtools.NewReindexRemote("")),
+			wantFatal: true,
+		},
+		{
+			name: "Sliced remote",
+			request: opensearchtools.NewReindexRequest(testIndex2, testIndex1).
+				WithRemote(opensearchtools.NewReindexRemote("https://otherhost:9200")).
+				WithSlices(2),
+			wantFatal: true,
+		},
+		{
+			name:      "Invalid script",
+			request:   opensearchtools.NewReindexRequest(testIndex2, testIndex1).WithScript(opensearchtools.NewScript("")),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainReindexRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestReindexRequest_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *opensearchtools.ReindexRequest
+		want    string
+	}{
+		{
+			name:    "Minimal request",
+			request: opensearchtools.NewReindexRequest(testIndex2, testIndex1),
+			want:    `{"source": {"index": ["test_index"]}, "dest": {"index": "test_index2"}}`,
+		},
+		{
+			name: "Full request",
+			request: opensearchtools.NewReindexRequest(testIndex2, testIndex1).
+				WithQuery(opensearchtools.NewTermQuery("field", "value")).
+				WithSize(500).
+				AddSorts(opensearchtools.NewSort("timestamp", true)).
+				WithRemote(opensearchtools.NewReindexRemote("https://otherhost:9200").
+					WithBasicAuth("user", "pass").
+					AddHeader("X-Header", "value").
+					WithTimeouts(time.Minute, 10*time.Second)).
+				WithOpType(opensearchtools.OpTypeCreate).
+				WithPipeline("pipeline").
+				WithConflicts(opensearchtools.ConflictsProceed).
+				WithScript(opensearchtools.NewScript("ctx._source.remove('field')")),
+			want: `{
+				"source": {
+					"index": ["test_index"],
+					"query": {"term": {"field": "value"}},
+					"size": 500,
+					"sort": [{"timestamp": {"order": "desc"}}],
+					"remote": {
+						"host": "https://otherhost:9200",
+						"username": "user",
+						"password": "pass",
+						"headers": {"X-Header": "value"},
+						"socket_timeout": "60000ms",
+						"connect_timeout": "10000ms"
+					}
+				},
+				"dest": {"index": "test_index2", "op_type": "create", "pipeline": "pipeline"},
+				"conflicts": "proceed",
+				"script": {"source": "ctx._source.remove('field')"}
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainReindexRequest(tt.request)
+			got, err := req.ToOpenSearchJSON()
+			require.Nil(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestExecutor_Reindex(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"took": 12, "total": 3, "created": 2, "updated": 1, "batches": 1, "retries": {"bulk": 0, "search": 0}, "failures": []}`)
+
+	req := opensearchtools.NewReindexRequest(testIndex2, testIndex1).
+		WithSlices(opensearchtools.SlicesAuto).
+		WithMaxDocs(3).
+		WithRequestsPerSecond(-1).
+		WithRefresh(true)
+
+	resp, err := executor.Reindex(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/_reindex", recorded.Path)
+	require.Equal(t, "auto", recorded.Query.Get("slices"))
+	require.Equal(t, "3", recorded.Query.Get("max_docs"))
+	require.Equal(t, "-1", recorded.Query.Get("requests_per_second"))
+	require.Equal(t, "true", recorded.Query.Get("refresh"))
+
+	require.False(t, resp.Response.Failed())
+	require.Equal(t, opensearchtools.BulkByScrollResponse{
+		Took:    12,
+		Total:   3,
+		Created: 2,
+		Updated: 1,
+		Batches: 1,
+	}, resp.Response)
+}
+
+func TestExecutor_ReindexAsync(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"task": "node_1:7"}`)
+
+	resp, err := executor.Reindex(context.Background(), opensearchtools.NewReindexRequest(testIndex2, testIndex1).WithWaitForCompletion(false))
+	require.Nil(t, err)
+	require.Equal(t, "false", recorded.Query.Get("wait_for_completion"))
+	require.Equal(t, "node_1:7", resp.Response.Task)
+}
@@ -0,0 +1,118 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// doRequest performs an HTTP request against an OpenSearch API which has no [opensearchapi] request type,
+// such as APIs introduced after opensearch-go v2 or provided by plugins.
+// The path segments are escaped and joined, and a JSON body is sent if body is non-nil.
+func doRequest(ctx context.Context, client *opensearch.Client, method string, pathSegments []string, params url.Values, body io.Reader) (*opensearchapi.Response, error) {
+	escaped := make([]string, len(pathSegments))
+	for i, segment := range pathSegments {
+		escaped[i] = url.PathEscape(segment)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, "/"+strings.Join(escaped, "/"), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		req.URL.RawQuery = params.Encode()
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &opensearchapi.Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       resp.Body,
+	}, nil
+}
+
+// decodeResponse reads and closes the body of the response, unmarshalling it into a T.
+// An empty body, such as the response to a HEAD request, results in the zero value of T.
+func decodeResponse[T any](osResp *opensearchapi.Response) (T, error) {
+	var decoded T
+
+	if osResp.Body == nil {
+		return decoded, nil
+	}
+	defer osResp.Body.Close()
+
+	var respBuf bytes.Buffer
+	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
+		return decoded, err
+	}
+
+	if respBuf.Len() == 0 {
+		return decoded, nil
+	}
+
+	if err := json.Unmarshal(respBuf.Bytes(), &decoded); err != nil {
+		return decoded, err
+	}
+
+	return decoded, nil
+}
+
+// formatDuration formats a duration in the time units expected by OpenSearch
+func formatDuration(d time.Duration) string {
+	if d < time.Millisecond {
+		return strconv.FormatInt(int64(d), 10) + "nanos"
+	}
+
+	return strconv.FormatInt(int64(d)/int64(time.Millisecond), 10) + "ms"
+}
+
+// durationUnits are the time units of OpenSearch, ordered so that no unit is a suffix of a following one
+var durationUnits = []struct {
+	suffix string
+	unit   time.Duration
+}{
+	{"nanos", time.Nanosecond},
+	{"micros", time.Microsecond},
+	{"ms", time.Millisecond},
+	{"s", time.Second},
+	{"m", time.Minute},
+	{"h", time.Hour},
+	{"d", 24 * time.Hour},
+}
+
+// parseDuration parses a duration in the time units of OpenSearch, such as 30s or 500ms
+func parseDuration(s string) (time.Duration, error) {
+	for _, du := range durationUnits {
+		if !strings.HasSuffix(s, du.suffix) {
+			continue
+		}
+
+		value, err := strconv.ParseInt(strings.TrimSuffix(s, du.suffix), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+
+		return time.Duration(value) * du.unit, nil
+	}
+
+	return 0, fmt.Errorf("invalid duration %q: missing time unit", s)
+}
@@ -0,0 +1,172 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// RolloverRequest is a serializable form of [opensearchtools.RolloverRequest] specific to
+// the [opensearchapi.IndicesRolloverRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/rollover/
+type RolloverRequest struct {
+	// Target is the data stream or the alias to roll over
+	Target string
+
+	// NewIndex is the name of the new index of an alias
+	NewIndex string
+
+	// MaxAge of the current index to roll over
+	MaxAge time.Duration
+
+	// MaxDocs of the current index to roll over
+	MaxDocs int64
+
+	// MaxSize of the current index to roll over
+	MaxSize string
+
+	// DryRun checks the conditions without rolling over
+	DryRun bool
+}
+
+// FromDomainRolloverRequest creates a new [RolloverRequest] from the given [opensearchtools.RolloverRequest].
+func FromDomainRolloverRequest(req *opensearchtools.RolloverRequest) (RolloverRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return RolloverRequest{
+		Target:   req.Target,
+		NewIndex: req.NewIndex,
+		MaxAge:   req.MaxAge,
+		MaxDocs:  req.MaxDocs,
+		MaxSize:  req.MaxSize,
+		DryRun:   req.DryRun,
+	}, vrs
+}
+
+// Validate validates the given RolloverRequest
+func (r *RolloverRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Target == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Target not set on the RolloverRequest", true))
+	}
+
+	if r.MaxAge < 0 || r.MaxDocs < 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("Negative condition on the RolloverRequest", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the RolloverRequest into the JSON shape expected by OpenSearch.
+func (r *RolloverRequest) ToOpenSearchJSON() ([]byte, error) {
+	conditions := make(map[string]any)
+
+	if r.MaxAge > 0 {
+		conditions["max_age"] = formatDuration(r.MaxAge)
+	}
+
+	if r.MaxDocs > 0 {
+		conditions["max_docs"] = r.MaxDocs
+	}
+
+	if r.MaxSize != "" {
+		conditions["max_size"] = r.MaxSize
+	}
+
+	source := make(map[string]any)
+	if len(conditions) > 0 {
+		source["conditions"] = conditions
+	}
+
+	return json.Marshal(source)
+}
+
+// Do executes the [RolloverRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [RolloverResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *RolloverRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[RolloverResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.IndicesRolloverRequest{
+		Alias:    r.Target,
+		NewIndex: r.NewIndex,
+		Body:     bytes.NewReader(bodyBytes),
+	}
+
+	if r.DryRun {
+		osReq.DryRun = &r.DryRun
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	rolloverResp, err := decodeResponse[RolloverResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		rolloverResp,
+	)
+	return &resp, nil
+}
+
+// RolloverResponse represents the response for a [RolloverRequest].
+type RolloverResponse struct {
+	Acknowledged       bool            `json:"acknowledged"`
+	ShardsAcknowledged bool            `json:"shards_acknowledged"`
+	OldIndex           string          `json:"old_index"`
+	NewIndex           string          `json:"new_index"`
+	RolledOver         bool            `json:"rolled_over"`
+	DryRun             bool            `json:"dry_run"`
+	Conditions         map[string]bool `json:"conditions"`
+	Error              *Error          `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [RolloverResponse] into an [opensearchtools.RolloverResponse].
+func (r RolloverResponse) toDomain() opensearchtools.RolloverResponse {
+	domainResp := opensearchtools.RolloverResponse{
+		Acknowledged:       r.Acknowledged,
+		ShardsAcknowledged: r.ShardsAcknowledged,
+		OldIndex:           r.OldIndex,
+		NewIndex:           r.NewIndex,
+		RolledOver:         r.RolledOver,
+		DryRun:             r.DryRun,
+		Conditions:         r.Conditions,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
@@ -0,0 +1,115 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestRolloverRequest_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *opensearchtools.RolloverRequest
+		want string
+	}{
+		{
+			name: "No conditions",
+			req:  opensearchtools.NewRolloverRequest("logs"),
+			want: `{}`,
+		},
+		{
+			name: "All conditions",
+			req: opensearchtools.NewRolloverRequest("logs").
+				WithMaxAge(24 * time.Hour).
+				WithMaxDocs(1000).
+				WithMaxSize("50gb"),
+			want: `{"conditions": {"max_age": "86400000ms", "max_docs": 1000, "max_size": "50gb"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainRolloverRequest(tt.req)
+			got, err := req.ToOpenSearchJSON()
+			require.Nil(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestRolloverRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *opensearchtools.RolloverRequest
+		wantFatal bool
+	}{
+		{
+			name:      "Valid request",
+			req:       opensearchtools.NewRolloverRequest("logs").WithMaxDocs(1000),
+			wantFatal: false,
+		},
+		{
+			name:      "Missing target",
+			req:       opensearchtools.NewRolloverRequest(""),
+			wantFatal: true,
+		},
+		{
+			name:      "Negative condition",
+			req:       opensearchtools.NewRolloverRequest("logs").WithMaxAge(-time.Hour),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainRolloverRequest(tt.req)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_Rollover(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"acknowledged": false,
+		"shards_acknowledged": false,
+		"old_index": "logs-000001",
+		"new_index": "logs-000002",
+		"rolled_over": false,
+		"dry_run": true,
+		"conditions": {"[max_docs: 1000]": true}
+	}`)
+
+	req := opensearchtools.NewRolloverRequest("logs").
+		WithNewIndex("logs-000002").
+		WithMaxDocs(1000).
+		WithDryRun(true)
+	resp, err := executor.Rollover(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/logs/_rollover/logs-000002", recorded.Path)
+	require.Equal(t, "true", recorded.Query.Get("dry_run"))
+	require.JSONEq(t, `{"conditions": {"max_docs": 1000}}`, string(recorded.Body))
+
+	require.Equal(t, opensearchtools.RolloverResponse{
+		OldIndex:   "logs-000001",
+		NewIndex:   "logs-000002",
+		DryRun:     true,
+		Conditions: map[string]bool{"[max_docs: 1000]": true},
+	}, resp.Response)
+}
+
+func TestExecutor_RolloverMissingTarget(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusBadRequest, `{
+		"error": {"type": "illegal_argument_exception", "reason": "rollover target [logs] does not exist"},
+		"status": 400
+	}`)
+
+	resp, err := executor.Rollover(context.Background(), opensearchtools.NewRolloverRequest("logs"))
+	require.Nil(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Equal(t, "illegal_argument_exception", resp.Response.Error.Type)
+}
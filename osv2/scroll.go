@@ -0,0 +1,212 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// ScrollRequest is a serializable form of [opensearchtools.ScrollRequest] specific to
+// the [opensearchapi.ScrollRequest] in OpenSearch V2.
+// The scroll ID is sent in the body, as scroll IDs can exceed the maximum length of a URL.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/scroll/
+type ScrollRequest struct {
+	// ScrollID of the scroll, as returned by the previous [SearchResponse]
+	ScrollID string
+
+	// Scroll extends the life of the scroll until the next request. Omitted if zero.
+	Scroll time.Duration
+}
+
+// FromDomainScrollRequest creates a new [ScrollRequest] from the given [opensearchtools.ScrollRequest].
+func FromDomainScrollRequest(req *opensearchtools.ScrollRequest) (ScrollRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return ScrollRequest{
+		ScrollID: req.ScrollID,
+		Scroll:   req.Scroll,
+	}, vrs
+}
+
+// Validate validates the given ScrollRequest
+func (r *ScrollRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.ScrollID == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("ScrollRequest requires a ScrollID", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the ScrollRequest into the JSON shape expected by OpenSearch.
+func (r *ScrollRequest) ToOpenSearchJSON() ([]byte, error) {
+	source := map[string]any{"scroll_id": r.ScrollID}
+	if r.Scroll > 0 {
+		source["scroll"] = formatDuration(r.Scroll)
+	}
+
+	return json.Marshal(source)
+}
+
+// Do executes the [ScrollRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then the next batch of results is returned in a [SearchResponse].
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *ScrollRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[SearchResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.ScrollRequest{
+		Body: bytes.NewReader(bodyBytes),
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	searchResp, err := decodeSearchResponse(osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		searchResp,
+	)
+	return &resp, nil
+}
+
+// ClearScrollRequest is a serializable form of [opensearchtools.ClearScrollRequest] specific to
+// the [opensearchapi.ClearScrollRequest] in OpenSearch V2.
+// Either ScrollIDs or All must be set.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/scroll/
+type ClearScrollRequest struct {
+	// ScrollIDs to be cleared
+	ScrollIDs []string
+
+	// All clears every scroll of the cluster, ScrollIDs are ignored
+	All bool
+}
+
+// FromDomainClearScrollRequest creates a new [ClearScrollRequest] from the given [opensearchtools.ClearScrollRequest].
+func FromDomainClearScrollRequest(req *opensearchtools.ClearScrollRequest) (ClearScrollRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return ClearScrollRequest{
+		ScrollIDs: req.ScrollIDs,
+		All:       req.All,
+	}, vrs
+}
+
+// Validate validates the given ClearScrollRequest
+func (r *ClearScrollRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if !r.All && len(r.ScrollIDs) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("ClearScrollRequest requires at least one ScrollID or All", true))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the ClearScrollRequest into the JSON shape expected by OpenSearch.
+// A request clearing all scrolls has no body.
+func (r *ClearScrollRequest) ToOpenSearchJSON() ([]byte, error) {
+	if r.All {
+		return nil, nil
+	}
+
+	return json.Marshal(map[string]any{"scroll_id": r.ScrollIDs})
+}
+
+// Do executes the [ClearScrollRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [ClearScrollResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *ClearScrollRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ClearScrollResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	var osReq opensearchapi.ClearScrollRequest
+	if r.All {
+		osReq.ScrollID = []string{"_all"}
+	} else {
+		bodyBytes, jErr := r.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		osReq.Body = bytes.NewReader(bodyBytes)
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	clearResp, err := decodeResponse[ClearScrollResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		clearResp,
+	)
+	return &resp, nil
+}
+
+// ClearScrollResponse represents the response for a [ClearScrollRequest].
+type ClearScrollResponse struct {
+	Succeeded bool   `json:"succeeded"`
+	NumFreed  int    `json:"num_freed"`
+	Error     *Error `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [ClearScrollResponse] into an [opensearchtools.ClearScrollResponse].
+func (r ClearScrollResponse) toDomain() opensearchtools.ClearScrollResponse {
+	domainResp := opensearchtools.ClearScrollResponse{
+		Succeeded: r.Succeeded,
+		NumFreed:  r.NumFreed,
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
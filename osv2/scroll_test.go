@@ -0,0 +1,105 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestScrollRequest_Validate(t *testing.T) {
+	req, _ := FromDomainScrollRequest(opensearchtools.NewScrollRequest("", time.Minute))
+	vrs := req.Validate()
+	require.True(t, vrs.IsFatal())
+
+	req, _ = FromDomainScrollRequest(opensearchtools.NewScrollRequest("scroll_1", time.Minute))
+	vrs = req.Validate()
+	require.False(t, vrs.IsFatal())
+}
+
+func TestSearchRequest_ValidateScroll(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.SearchRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Scroll",
+			request: opensearchtools.NewSearchRequest().WithScroll(time.Minute),
+		},
+		{
+			name:      "Scroll with point in time",
+			request:   opensearchtools.NewSearchRequest().WithScroll(time.Minute).WithPointInTime("pit_1", time.Minute),
+			wantFatal: true,
+		},
+		{
+			name: "Scroll with search after",
+			request: opensearchtools.NewSearchRequest().
+				WithScroll(time.Minute).
+				AddSorts(opensearchtools.NewSort("_id", false)).
+				WithSearchAfter(testID1),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainSearchRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_SearchScroll(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"took": 1, "_scroll_id": "scroll_1", "hits": {"hits": [{"_id": "test_id"}]}}`)
+
+	resp, err := executor.Search(context.Background(), opensearchtools.NewSearchRequest().AddIndices(testIndex1).WithScroll(time.Minute))
+	require.Nil(t, err)
+	require.Equal(t, "/test_index/_search", recorded.Path)
+	require.Equal(t, "60000ms", recorded.Query.Get("scroll"))
+	require.Equal(t, "scroll_1", resp.Response.ScrollID)
+	require.Len(t, resp.Response.Hits.Hits, 1)
+}
+
+func TestExecutor_Scroll(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK,
+		`{"took": 1, "_scroll_id": "scroll_2", "hits": {"hits": [{"_index": "test_index", "_id": "test_id"}]}}`)
+
+	resp, err := executor.Scroll(context.Background(), opensearchtools.NewScrollRequest("scroll_1", time.Minute))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/_search/scroll", recorded.Path)
+	require.JSONEq(t, `{"scroll_id": "scroll_1", "scroll": "60000ms"}`, string(recorded.Body))
+
+	require.Equal(t, "scroll_2", resp.Response.ScrollID)
+	require.Equal(t, []opensearchtools.Hit{{Index: testIndex1, ID: testID1}}, resp.Response.Hits.Hits)
+
+	_, err = executor.Scroll(context.Background(), opensearchtools.NewScrollRequest("", time.Minute))
+	require.NotNil(t, err)
+}
+
+func TestExecutor_ClearScroll(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"succeeded": true, "num_freed": 2}`)
+	wantResp := opensearchtools.ClearScrollResponse{Succeeded: true, NumFreed: 2}
+
+	resp, err := executor.ClearScroll(context.Background(), opensearchtools.NewClearScrollRequest("scroll_1", "scroll_2"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, recorded.Method)
+	require.Equal(t, "/_search/scroll", recorded.Path)
+	require.JSONEq(t, `{"scroll_id": ["scroll_1", "scroll_2"]}`, string(recorded.Body))
+	require.Equal(t, wantResp, resp.Response)
+
+	resp, err = executor.ClearScroll(context.Background(), &opensearchtools.ClearScrollRequest{All: true})
+	require.Nil(t, err)
+	require.Equal(t, "/_search/scroll/_all", recorded.Path)
+	require.Len(t, recorded.Body, 0)
+	require.Equal(t, wantResp, resp.Response)
+
+	_, err = executor.ClearScroll(context.Background(), opensearchtools.NewClearScrollRequest())
+	require.NotNil(t, err)
+}
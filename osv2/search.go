@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/opensearch-project/opensearch-go/v2"
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
@@ -45,6 +46,19 @@ type SearchRequest struct {
 
 	// Aggregations to be performed on the results of the Query
 	Aggregations map[string]opensearchtools.Aggregation
+
+	// SearchAfter are the sort values of the last hit of the previous page, used to retrieve the next page
+	SearchAfter []any
+
+	// PointInTime searches a consistent snapshot of the indices the PIT was created for.
+	// When set, Index is not sent as a PIT is bound to its indices.
+	PointInTime *opensearchtools.PointInTime
+
+	// Scroll starts a scroll kept alive for the duration. Omitted if zero.
+	Scroll time.Duration
+
+	// Slice restricts a scroll or point-in-time search to one slice of its results
+	Slice *opensearchtools.Slice
 }
 
 // V2QueryConverter will do any translations needed from domain level queries into V2 specifics, if needed.
@@ -77,6 +91,16 @@ func NewSearchRequest() *SearchRequest {
 
 // ToOpenSearchJSON marshals the SearchRequest into the JSON shape expected by OpenSearch.
 func (r *SearchRequest) ToOpenSearchJSON() ([]byte, error) {
+	source, err := r.source()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(source)
+}
+
+// source builds the body of the SearchRequest, before it is marshaled to JSON.
+func (r *SearchRequest) source() (map[string]any, error) {
 	source := make(map[string]any)
 	if r.Query != nil {
 		queryJSON, jErr := r.Query.ToOpenSearchJSON()
@@ -109,6 +133,32 @@ func (r *SearchRequest) ToOpenSearchJSON() ([]byte, error) {
 		source["sort"] = sorts
 	}
 
+	if len(r.SearchAfter) > 0 {
+		source["search_after"] = r.SearchAfter
+	}
+
+	if r.PointInTime != nil {
+		pit := map[string]any{"id": r.PointInTime.ID}
+		if r.PointInTime.KeepAlive > 0 {
+			pit["keep_alive"] = formatDuration(r.PointInTime.KeepAlive)
+		}
+
+		source["pit"] = pit
+	}
+
+	if r.Slice != nil {
+		slice := map[string]any{
+			"id":  r.Slice.ID,
+			"max": r.Slice.Max,
+		}
+
+		if r.Slice.Field != "" {
+			slice["field"] = r.Slice.Field
+		}
+
+		source["slice"] = slice
+	}
+
 	if len(r.Aggregations) > 0 {
 		aggs := make(map[string]any, len(r.Aggregations))
 		for name, agg := range r.Aggregations {
@@ -123,7 +173,7 @@ func (r *SearchRequest) ToOpenSearchJSON() ([]byte, error) {
 		source["aggs"] = aggs
 	}
 
-	return json.Marshal(source)
+	return source, nil
 }
 
 // AddAggregation to the search request with the desired name
@@ -182,6 +232,40 @@ func (r *SearchRequest) WithRouting(routing ...string) *SearchRequest {
 	return r
 }
 
+// WithSearchAfter sets the sort values of the last hit of the previous page to retrieve the next page of results.
+func (r *SearchRequest) WithSearchAfter(values ...any) *SearchRequest {
+	r.SearchAfter = values
+	return r
+}
+
+// WithPointInTime searches the point-in-time with the given id, extending its life by keepAlive.
+func (r *SearchRequest) WithPointInTime(id string, keepAlive time.Duration) *SearchRequest {
+	r.PointInTime = &opensearchtools.PointInTime{
+		ID:        id,
+		KeepAlive: keepAlive,
+	}
+
+	return r
+}
+
+// WithScroll starts a scroll kept alive for keepAlive.
+func (r *SearchRequest) WithScroll(keepAlive time.Duration) *SearchRequest {
+	r.Scroll = keepAlive
+	return r
+}
+
+// WithSlice restricts a scroll or point-in-time search to the slice with the given id of maxSlices slices,
+// split on the given field, or on _id if field is empty.
+func (r *SearchRequest) WithSlice(id, maxSlices int, field string) *SearchRequest {
+	r.Slice = &opensearchtools.Slice{
+		ID:    id,
+		Max:   maxSlices,
+		Field: field,
+	}
+
+	return r
+}
+
 // FromDomainSearchRequest creates a new SearchRequest from the given [opensearchtools.SearchRequest]
 func FromDomainSearchRequest(req *opensearchtools.SearchRequest) (SearchRequest, opensearchtools.ValidationResults) {
 	vrs := opensearchtools.NewValidationResults()
@@ -221,6 +305,10 @@ func FromDomainSearchRequest(req *opensearchtools.SearchRequest) (SearchRequest,
 	searchRequest.Aggregations = aggs
 	searchRequest.TrackTotalHits = req.TrackTotalHits
 	searchRequest.Routing = req.Routing
+	searchRequest.SearchAfter = req.SearchAfter
+	searchRequest.PointInTime = req.PointInTime
+	searchRequest.Scroll = req.Scroll
+	searchRequest.Slice = req.Slice
 
 	return searchRequest, vrs
 }
@@ -228,6 +316,49 @@ func FromDomainSearchRequest(req *opensearchtools.SearchRequest) (SearchRequest,
 // Validate validates the given SearchRequest
 func (r *SearchRequest) Validate() opensearchtools.ValidationResults {
 	var validationResults opensearchtools.ValidationResults
+
+	if len(r.SearchAfter) > 0 {
+		if len(r.Sort) == 0 {
+			validationResults.Add(opensearchtools.NewValidationResult("SearchAfter requires the SearchRequest to be sorted", true))
+		}
+
+		if r.From > 0 {
+			validationResults.Add(opensearchtools.NewValidationResult("SearchAfter cannot be used with a From greater than zero", true))
+		}
+	}
+
+	if r.PointInTime != nil {
+		if r.PointInTime.ID == "" {
+			validationResults.Add(opensearchtools.NewValidationResult("PointInTime requires an ID", true))
+		}
+
+		if len(r.Index) > 0 {
+			validationResults.Add(opensearchtools.NewValidationResult("Index is ignored when searching a PointInTime", false))
+		}
+
+		if r.Scroll > 0 {
+			validationResults.Add(opensearchtools.NewValidationResult("Scroll cannot be used with a PointInTime", true))
+		}
+	}
+
+	if r.Scroll > 0 && len(r.SearchAfter) > 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("Scroll cannot be used with SearchAfter", true))
+	}
+
+	if r.Slice != nil {
+		if r.Scroll <= 0 && r.PointInTime == nil {
+			validationResults.Add(opensearchtools.NewValidationResult("Slice requires a Scroll or a PointInTime", true))
+		}
+
+		if r.Slice.Max < 2 {
+			validationResults.Add(opensearchtools.NewValidationResult("Slice requires a Max of at least 2", true))
+		}
+
+		if r.Slice.ID < 0 || r.Slice.ID >= r.Slice.Max {
+			validationResults.Add(opensearchtools.NewValidationResult("Slice ID must be between 0 and Max - 1", true))
+		}
+	}
+
 	return validationResults
 }
 
@@ -235,39 +366,48 @@ func (r *SearchRequest) Validate() opensearchtools.ValidationResults {
 // If the request is executed successfully, then a SearchResponse will be returned.
 // An error can be returned if
 //
+//   - Fatal validation issues are found
 //   - The SearchRequest source cannot be created
 //   - The source fails to be marshaled to JSON
 //   - The OpenSearch request fails to executed
 //   - The OpenSearch response cannot be parsed
 func (r *SearchRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[SearchResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
 	bodyBytes, jErr := r.ToOpenSearchJSON()
 	if jErr != nil {
 		return nil, jErr
 	}
 
-	osResp, rErr := opensearchapi.SearchRequest{
+	osReq := opensearchapi.SearchRequest{
 		Index:          r.Index,
 		Body:           bytes.NewReader(bodyBytes),
 		TrackTotalHits: r.TrackTotalHits,
 		Routing:        r.Routing,
-	}.Do(ctx, client)
+		Scroll:         r.Scroll,
+	}
 
-	if rErr != nil {
-		return nil, rErr
+	// a PIT is bound to its indices, which must not be part of the path
+	if r.PointInTime != nil {
+		osReq.Index = nil
 	}
 
-	var respBuf bytes.Buffer
-	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
-		return nil, err
+	osResp, rErr := osReq.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
 	}
 
-	var searchResp SearchResponse
-	if err := json.Unmarshal(respBuf.Bytes(), &searchResp); err != nil {
+	searchResp, err := decodeSearchResponse(osResp)
+	if err != nil {
 		return nil, err
 	}
 
 	resp := opensearchtools.NewOpenSearchResponse(
-		opensearchtools.NewValidationResults(), // no additional validation
+		vrs,
 		osResp.StatusCode,
 		osResp.Header,
 		searchResp,
@@ -275,6 +415,26 @@ func (r *SearchRequest) Do(ctx context.Context, client *opensearch.Client) (*ope
 	return &resp, nil
 }
 
+// decodeSearchResponse reads and closes the body of the response, unmarshalling it into a SearchResponse.
+// Numbers are decoded as json.Number so long sort values keep their precision when searching after them.
+func decodeSearchResponse(osResp *opensearchapi.Response) (SearchResponse, error) {
+	defer osResp.Body.Close()
+
+	var respBuf bytes.Buffer
+	if _, err := respBuf.ReadFrom(osResp.Body); err != nil {
+		return SearchResponse{}, err
+	}
+
+	var searchResp SearchResponse
+	decoder := json.NewDecoder(&respBuf)
+	decoder.UseNumber()
+	if err := decoder.Decode(&searchResp); err != nil {
+		return SearchResponse{}, err
+	}
+
+	return searchResp, nil
+}
+
 // SearchResponse wraps the functionality of [opensearchapi.Response] by supporting request parsing.
 type SearchResponse struct {
 	Took         int                        `json:"took"`
@@ -283,6 +443,8 @@ type SearchResponse struct {
 	Hits         Hits                       `json:"hits"`
 	Error        *Error                     `json:"error,omitempty"`
 	Aggregations map[string]json.RawMessage `json:"aggregations,omitempty"`
+	PITID        string                     `json:"pit_id,omitempty"`
+	ScrollID     string                     `json:"_scroll_id,omitempty"`
 }
 
 // GetAggregationResultSource implements [opensearchtools.AggregationResultSet] to fetch an aggregation result and
@@ -309,6 +471,8 @@ func (sr *SearchResponse) toDomain() opensearchtools.SearchResponse {
 		Shards:       sr.Shards.toDomain(),
 		Hits:         sr.Hits.toDomain(),
 		Aggregations: sr.Aggregations,
+		PITID:        sr.PITID,
+		ScrollID:     sr.ScrollID,
 	}
 
 	if sr.Error != nil {
@@ -360,6 +524,7 @@ type Hit struct {
 	ID     string          `json:"_id"`
 	Score  float64         `json:"_score"`
 	Source json.RawMessage `json:"_source"`
+	Sort   []any           `json:"sort,omitempty"`
 }
 
 // toDomain converts this instance of a [Hit] into an [opensearchtools.Hit].
@@ -369,6 +534,7 @@ func (h Hit) toDomain() opensearchtools.Hit {
 		ID:     h.ID,
 		Score:  h.Score,
 		Source: h.Source,
+		Sort:   h.Sort,
 	}
 }
 
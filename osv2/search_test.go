@@ -3,6 +3,7 @@ package osv2
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -82,6 +83,22 @@ func TestSearchRequest_ToOpenSearchJSON(t *testing.T) {
 			want:    `{}`,
 			wantErr: false,
 		},
+		{
+			name: "Search After",
+			search: NewSearchRequest().
+				AddSorts(opensearchtools.NewSort("field", false)).
+				WithSearchAfter(10, "test_id"),
+			want:    `{"sort":[{"field":{"order":"asc"}}],"search_after":[10,"test_id"]}`,
+			wantErr: false,
+		},
+		{
+			name: "With Slice",
+			search: NewSearchRequest().
+				WithPointInTime("pit_1", 0).
+				WithSlice(1, 4, "timestamp"),
+			want:    `{"pit":{"id":"pit_1"},"slice":{"id":1,"max":4,"field":"timestamp"}}`,
+			wantErr: false,
+		},
 		{
 			name: "With Terms Aggregation",
 			search: NewSearchRequest().
@@ -103,6 +120,77 @@ func TestSearchRequest_ToOpenSearchJSON(t *testing.T) {
 	}
 }
 
+func TestSearchRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		search    *SearchRequest
+		wantFatal bool
+	}{
+		{
+			name:   "Empty request",
+			search: NewSearchRequest(),
+		},
+		{
+			name: "Sorted search after",
+			search: NewSearchRequest().
+				AddSorts(opensearchtools.NewSort("field", false)).
+				WithSearchAfter(10),
+		},
+		{
+			name: "Unsorted search after",
+			search: NewSearchRequest().
+				WithSearchAfter(10),
+			wantFatal: true,
+		},
+		{
+			name: "Search after with from",
+			search: NewSearchRequest().
+				AddSorts(opensearchtools.NewSort("field", false)).
+				WithSearchAfter(10).
+				WithFrom(10),
+			wantFatal: true,
+		},
+		{
+			name: "Sliced point in time",
+			search: NewSearchRequest().
+				WithPointInTime("pit_1", time.Minute).
+				WithSlice(0, 2, ""),
+		},
+		{
+			name: "Sliced scroll",
+			search: NewSearchRequest().
+				WithScroll(time.Minute).
+				WithSlice(1, 2, ""),
+		},
+		{
+			name: "Slice without scroll or point in time",
+			search: NewSearchRequest().
+				WithSlice(0, 2, ""),
+			wantFatal: true,
+		},
+		{
+			name: "Slice ID out of range",
+			search: NewSearchRequest().
+				WithScroll(time.Minute).
+				WithSlice(2, 2, ""),
+			wantFatal: true,
+		},
+		{
+			name: "Single slice",
+			search: NewSearchRequest().
+				WithScroll(time.Minute).
+				WithSlice(0, 1, ""),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrs := tt.search.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
 func TestHit_ToDomain(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -121,12 +209,14 @@ func TestHit_ToDomain(t *testing.T) {
 				ID:     testID1,
 				Score:  10,
 				Source: json.RawMessage("source"),
+				Sort:   []any{float64(10), testID1},
 			},
 			want: opensearchtools.Hit{
 				Index:  testIndex1,
 				ID:     testID1,
 				Score:  10,
 				Source: json.RawMessage("source"),
+				Sort:   []any{float64(10), testID1},
 			},
 		},
 	}
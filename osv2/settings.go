@@ -0,0 +1,396 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// refreshIntervalDisabled is the refresh_interval disabling the periodic refreshes of an index
+const refreshIntervalDisabled = "-1"
+
+// IndexSettings is the serializable form of an [opensearchtools.IndexSettings] in OpenSearch V2.
+// OpenSearch returns the values of the settings as strings, so they are sent as strings as well.
+//
+// For more details see https://opensearch.org/docs/latest/install-and-configure/configuring-opensearch/index-settings/
+type IndexSettings struct {
+	NumberOfShards     string    `json:"number_of_shards,omitempty"`
+	NumberOfReplicas   string    `json:"number_of_replicas,omitempty"`
+	AutoExpandReplicas string    `json:"auto_expand_replicas,omitempty"`
+	RefreshInterval    string    `json:"refresh_interval,omitempty"`
+	MaxResultWindow    string    `json:"max_result_window,omitempty"`
+	KNN                string    `json:"knn,omitempty"`
+	Analysis           *Analysis `json:"analysis,omitempty"`
+
+	// Reset lists the names of the settings reset to their default value, marshaled as null
+	Reset []string `json:"-"`
+}
+
+// MarshalJSON marshals the IndexSettings, adding a null value for each of the Reset settings.
+func (s IndexSettings) MarshalJSON() ([]byte, error) {
+	// plainIndexSettings drops the MarshalJSON method to avoid recursing
+	type plainIndexSettings IndexSettings
+
+	settingsBytes, err := json.Marshal(plainIndexSettings(s))
+	if err != nil || len(s.Reset) == 0 {
+		return settingsBytes, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(settingsBytes, &fields); err != nil {
+		return nil, err
+	}
+
+	for _, name := range s.Reset {
+		fields[name] = json.RawMessage("null")
+	}
+
+	return json.Marshal(fields)
+}
+
+// isSet returns true if the setting with the given name has a value
+func (s *IndexSettings) isSet(name string) bool {
+	switch opensearchtools.IndexSetting(name) {
+	case opensearchtools.IndexSettingNumberOfReplicas:
+		return s.NumberOfReplicas != ""
+	case opensearchtools.IndexSettingAutoExpandReplicas:
+		return s.AutoExpandReplicas != ""
+	case opensearchtools.IndexSettingRefreshInterval:
+		return s.RefreshInterval != ""
+	case opensearchtools.IndexSettingMaxResultWindow:
+		return s.MaxResultWindow != ""
+	default:
+		return false
+	}
+}
+
+// Analysis is the serializable form of an [opensearchtools.Analysis] in OpenSearch V2.
+type Analysis struct {
+	Analyzer   map[string]map[string]any `json:"analyzer,omitempty"`
+	Tokenizer  map[string]map[string]any `json:"tokenizer,omitempty"`
+	Filter     map[string]map[string]any `json:"filter,omitempty"`
+	CharFilter map[string]map[string]any `json:"char_filter,omitempty"`
+	Normalizer map[string]map[string]any `json:"normalizer,omitempty"`
+}
+
+// FromDomainIndexSettings creates new [IndexSettings] from the given [opensearchtools.IndexSettings].
+func FromDomainIndexSettings(settings *opensearchtools.IndexSettings) *IndexSettings {
+	if settings == nil {
+		return nil
+	}
+
+	converted := &IndexSettings{
+		AutoExpandReplicas: settings.AutoExpandReplicas,
+	}
+
+	if settings.NumberOfShards > 0 {
+		converted.NumberOfShards = strconv.Itoa(settings.NumberOfShards)
+	}
+
+	if settings.NumberOfReplicas != nil {
+		converted.NumberOfReplicas = strconv.Itoa(*settings.NumberOfReplicas)
+	}
+
+	if settings.RefreshInterval != nil {
+		if *settings.RefreshInterval < 0 {
+			converted.RefreshInterval = refreshIntervalDisabled
+		} else {
+			converted.RefreshInterval = formatDuration(*settings.RefreshInterval)
+		}
+	}
+
+	if settings.MaxResultWindow > 0 {
+		converted.MaxResultWindow = strconv.Itoa(settings.MaxResultWindow)
+	}
+
+	if settings.KNN != nil {
+		converted.KNN = strconv.FormatBool(*settings.KNN)
+	}
+
+	if analysis := settings.Analysis; analysis != nil {
+		converted.Analysis = &Analysis{
+			Analyzer:   analysis.Analyzers,
+			Tokenizer:  analysis.Tokenizers,
+			Filter:     analysis.Filters,
+			CharFilter: analysis.CharFilters,
+			Normalizer: analysis.Normalizers,
+		}
+	}
+
+	for _, name := range settings.Reset {
+		converted.Reset = append(converted.Reset, string(name))
+	}
+
+	return converted
+}
+
+// toDomain converts this instance of [IndexSettings] into [opensearchtools.IndexSettings].
+// Values which can't be parsed are left unset.
+func (s *IndexSettings) toDomain() *opensearchtools.IndexSettings {
+	converted := &opensearchtools.IndexSettings{
+		AutoExpandReplicas: s.AutoExpandReplicas,
+	}
+
+	if shards, err := strconv.Atoi(s.NumberOfShards); err == nil {
+		converted.NumberOfShards = shards
+	}
+
+	if replicas, err := strconv.Atoi(s.NumberOfReplicas); err == nil {
+		converted.NumberOfReplicas = &replicas
+	}
+
+	if s.RefreshInterval == refreshIntervalDisabled {
+		disabled := opensearchtools.RefreshIntervalDisabled
+		converted.RefreshInterval = &disabled
+	} else if interval, err := parseDuration(s.RefreshInterval); err == nil {
+		converted.RefreshInterval = &interval
+	}
+
+	if maxResultWindow, err := strconv.Atoi(s.MaxResultWindow); err == nil {
+		converted.MaxResultWindow = maxResultWindow
+	}
+
+	if knn, err := strconv.ParseBool(s.KNN); err == nil {
+		converted.KNN = &knn
+	}
+
+	if analysis := s.Analysis; analysis != nil {
+		converted.Analysis = &opensearchtools.Analysis{
+			Analyzers:   analysis.Analyzer,
+			Tokenizers:  analysis.Tokenizer,
+			Filters:     analysis.Filter,
+			CharFilters: analysis.CharFilter,
+			Normalizers: analysis.Normalizer,
+		}
+	}
+
+	return converted
+}
+
+// withDefaults fills the settings which aren't set with their value in defaults
+func (s *IndexSettings) withDefaults(defaults *IndexSettings) *IndexSettings {
+	merged := *s
+	if defaults == nil {
+		return &merged
+	}
+
+	fill := func(value *string, defaultValue string) {
+		if *value == "" {
+			*value = defaultValue
+		}
+	}
+
+	fill(&merged.NumberOfShards, defaults.NumberOfShards)
+	fill(&merged.NumberOfReplicas, defaults.NumberOfReplicas)
+	fill(&merged.AutoExpandReplicas, defaults.AutoExpandReplicas)
+	fill(&merged.RefreshInterval, defaults.RefreshInterval)
+	fill(&merged.MaxResultWindow, defaults.MaxResultWindow)
+	fill(&merged.KNN, defaults.KNN)
+
+	return &merged
+}
+
+// indexSettingsBody is the body of the settings APIs, which nest the index settings under the index key
+type indexSettingsBody struct {
+	Index *IndexSettings `json:"index,omitempty"`
+}
+
+// GetSettingsRequest is a serializable form of [opensearchtools.GetSettingsRequest] specific to
+// the [opensearchapi.IndicesGetSettingsRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/get-settings/
+type GetSettingsRequest struct {
+	// Indices whose settings to get, wildcards are supported. All indices if empty.
+	Indices []string
+
+	// IncludeDefaults fills the settings which aren't set explicitly with their default value
+	IncludeDefaults bool
+}
+
+// FromDomainGetSettingsRequest creates a new [GetSettingsRequest] from the given [opensearchtools.GetSettingsRequest].
+func FromDomainGetSettingsRequest(req *opensearchtools.GetSettingsRequest) (GetSettingsRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetSettingsRequest{
+		Indices:         req.Indices,
+		IncludeDefaults: req.IncludeDefaults,
+	}, vrs
+}
+
+// Do executes the [GetSettingsRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetSettingsResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetSettingsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetSettingsResponse], error) {
+	osReq := opensearchapi.IndicesGetSettingsRequest{
+		Index: r.Indices,
+	}
+
+	if r.IncludeDefaults {
+		includeDefaults := r.IncludeDefaults
+		osReq.IncludeDefaults = &includeDefaults
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var settingsResp GetSettingsResponse
+	if osResp.IsError() {
+		errResp, err := decodeResponse[errorResponse](osResp)
+		if err != nil {
+			return nil, err
+		}
+
+		settingsResp.Error = errResp.Error
+	} else {
+		indicesSettings, err := decodeResponse[map[string]indexSettings](osResp)
+		if err != nil {
+			return nil, err
+		}
+
+		settingsResp.Settings = make(map[string]*IndexSettings, len(indicesSettings))
+		for index, settings := range indicesSettings {
+			explicit := settings.Settings.Index
+			if explicit == nil {
+				explicit = &IndexSettings{}
+			}
+
+			settingsResp.Settings[index] = explicit.withDefaults(settings.Defaults.Index)
+		}
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		settingsResp,
+	)
+	return &resp, nil
+}
+
+// indexSettings are the settings of an index in the response of a [GetSettingsRequest]
+type indexSettings struct {
+	Settings indexSettingsBody `json:"settings"`
+	Defaults indexSettingsBody `json:"defaults"`
+}
+
+// GetSettingsResponse represents the response for a [GetSettingsRequest].
+// The settings of each index include the default values if requested.
+type GetSettingsResponse struct {
+	Settings map[string]*IndexSettings
+	Error    *Error
+}
+
+// toDomain converts this instance of a [GetSettingsResponse] into an [opensearchtools.GetSettingsResponse].
+func (r GetSettingsResponse) toDomain() opensearchtools.GetSettingsResponse {
+	var domainResp opensearchtools.GetSettingsResponse
+
+	if r.Settings != nil {
+		domainResp.Settings = make(map[string]*opensearchtools.IndexSettings, len(r.Settings))
+		for index, settings := range r.Settings {
+			domainResp.Settings[index] = settings.toDomain()
+		}
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// UpdateSettingsRequest is a serializable form of [opensearchtools.UpdateSettingsRequest] specific to
+// the [opensearchapi.IndicesPutSettingsRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/index-apis/update-settings/
+type UpdateSettingsRequest struct {
+	// Indices whose settings to update, wildcards are supported
+	Indices []string
+
+	// Settings to apply
+	Settings *IndexSettings
+}
+
+// FromDomainUpdateSettingsRequest creates a new [UpdateSettingsRequest] from the given [opensearchtools.UpdateSettingsRequest].
+func FromDomainUpdateSettingsRequest(req *opensearchtools.UpdateSettingsRequest) (UpdateSettingsRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return UpdateSettingsRequest{
+		Indices:  req.Indices,
+		Settings: FromDomainIndexSettings(req.Settings),
+	}, vrs
+}
+
+// Validate validates the given UpdateSettingsRequest
+func (r *UpdateSettingsRequest) Validate() opensearchtools.ValidationResults {
+	validationResults := validateIndices("UpdateSettingsRequest", r.Indices)
+
+	if r.Settings == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Settings not set on the UpdateSettingsRequest", true))
+	} else {
+		if r.Settings.NumberOfShards != "" {
+			validationResults.Add(opensearchtools.NewValidationResult("NumberOfShards cannot be updated on the UpdateSettingsRequest", true))
+		}
+
+		for _, name := range r.Settings.Reset {
+			if r.Settings.isSet(name) {
+				validationResults.Add(opensearchtools.NewValidationResult(
+					fmt.Sprintf("Setting %s both set and reset on the UpdateSettingsRequest", name), true))
+			}
+		}
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the UpdateSettingsRequest into the JSON shape expected by OpenSearch.
+func (r *UpdateSettingsRequest) ToOpenSearchJSON() ([]byte, error) {
+	return json.Marshal(indexSettingsBody{Index: r.Settings})
+}
+
+// Do executes the [UpdateSettingsRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *UpdateSettingsRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osResp, rErr := opensearchapi.IndicesPutSettingsRequest{
+		Index: r.Indices,
+		Body:  bytes.NewReader(bodyBytes),
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
@@ -0,0 +1,208 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestUpdateSettingsRequest_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *opensearchtools.IndexSettings
+		want     string
+	}{
+		{
+			name:     "Bulk load settings",
+			settings: opensearchtools.BulkLoadSettings(),
+			want:     `{"index": {"number_of_replicas": "0", "refresh_interval": "-1"}}`,
+		},
+		{
+			name: "Reset settings",
+			settings: opensearchtools.NewIndexSettings().
+				WithNumberOfReplicas(1).
+				WithReset(opensearchtools.IndexSettingRefreshInterval, opensearchtools.IndexSettingMaxResultWindow),
+			want: `{"index": {"number_of_replicas": "1", "refresh_interval": null, "max_result_window": null}}`,
+		},
+		{
+			name: "All settings",
+			settings: opensearchtools.NewIndexSettings().
+				WithNumberOfReplicas(2).
+				WithAutoExpandReplicas("0-all").
+				WithRefreshInterval(30 * time.Second).
+				WithMaxResultWindow(50000).
+				WithKNN(true).
+				WithAnalysis(opensearchtools.NewAnalysis().
+					AddAnalyzer("custom", map[string]any{"type": "custom", "tokenizer": "standard", "filter": []string{"lowercase"}}).
+					AddTokenizer("ngram", map[string]any{"type": "ngram"}).
+					AddFilter("stop", map[string]any{"type": "stop"}).
+					AddCharFilter("html", map[string]any{"type": "html_strip"}).
+					AddNormalizer("lowercase", map[string]any{"type": "custom", "filter": []string{"lowercase"}})),
+			want: `{"index": {
+				"number_of_replicas": "2",
+				"auto_expand_replicas": "0-all",
+				"refresh_interval": "30000ms",
+				"max_result_window": "50000",
+				"knn": "true",
+				"analysis": {
+					"analyzer": {"custom": {"type": "custom", "tokenizer": "standard", "filter": ["lowercase"]}},
+					"tokenizer": {"ngram": {"type": "ngram"}},
+					"filter": {"stop": {"type": "stop"}},
+					"char_filter": {"html": {"type": "html_strip"}},
+					"normalizer": {"lowercase": {"type": "custom", "filter": ["lowercase"]}}
+				}
+			}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainUpdateSettingsRequest(opensearchtools.NewUpdateSettingsRequest(tt.settings, testIndex1))
+			got, err := req.ToOpenSearchJSON()
+			require.Nil(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestUpdateSettingsRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.UpdateSettingsRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewUpdateSettingsRequest(opensearchtools.NewIndexSettings().WithNumberOfReplicas(1), testIndex1),
+		},
+		{
+			name:      "Missing indices",
+			request:   opensearchtools.NewUpdateSettingsRequest(opensearchtools.NewIndexSettings()),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing settings",
+			request:   opensearchtools.NewUpdateSettingsRequest(nil, testIndex1),
+			wantFatal: true,
+		},
+		{
+			name:      "Number of shards",
+			request:   opensearchtools.NewUpdateSettingsRequest(opensearchtools.NewIndexSettings().WithNumberOfShards(2), testIndex1),
+			wantFatal: true,
+		},
+		{
+			name: "Reset setting",
+			request: opensearchtools.NewUpdateSettingsRequest(
+				opensearchtools.NewIndexSettings().WithReset(opensearchtools.IndexSettingRefreshInterval), testIndex1),
+		},
+		{
+			name: "Setting both set and reset",
+			request: opensearchtools.NewUpdateSettingsRequest(
+				opensearchtools.NewIndexSettings().WithRefreshInterval(time.Second).WithReset(opensearchtools.IndexSettingRefreshInterval), testIndex1),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainUpdateSettingsRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_UpdateSettings(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	req := opensearchtools.NewUpdateSettingsRequest(opensearchtools.NewIndexSettings().WithNumberOfReplicas(0), testIndex1)
+	resp, err := executor.UpdateSettings(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, recorded.Method)
+	require.Equal(t, "/test_index/_settings", recorded.Path)
+	require.JSONEq(t, `{"index": {"number_of_replicas": "0"}}`, string(recorded.Body))
+	require.True(t, resp.Response.Acknowledged)
+}
+
+func TestExecutor_GetSettings(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"test_index": {
+			"settings": {"index": {
+				"number_of_shards": "3",
+				"number_of_replicas": "1",
+				"refresh_interval": "-1",
+				"creation_date": "1658146048666",
+				"analysis": {"analyzer": {"custom": {"type": "custom", "tokenizer": "standard"}}}
+			}},
+			"defaults": {"index": {"refresh_interval": "1s", "max_result_window": "10000", "auto_expand_replicas": "false"}}
+		},
+		"test_index2": {
+			"settings": {"index": {"number_of_shards": "1", "number_of_replicas": "0", "knn": "true"}},
+			"defaults": {"index": {"refresh_interval": "500ms", "max_result_window": "10000", "auto_expand_replicas": "false"}}
+		}
+	}`)
+
+	resp, err := executor.GetSettings(context.Background(), opensearchtools.NewGetSettingsRequest(testIndex1, testIndex2).WithIncludeDefaults(true))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/test_index,test_index2/_settings", recorded.Path)
+	require.Equal(t, "true", recorded.Query.Get("include_defaults"))
+
+	require.Nil(t, resp.Response.Error)
+	require.Equal(t, map[string]*opensearchtools.IndexSettings{
+		testIndex1: opensearchtools.NewIndexSettings().
+			WithNumberOfShards(3).
+			WithNumberOfReplicas(1).
+			WithAutoExpandReplicas("false").
+			WithRefreshInterval(opensearchtools.RefreshIntervalDisabled).
+			WithMaxResultWindow(10000).
+			WithAnalysis(opensearchtools.NewAnalysis().AddAnalyzer("custom", map[string]any{"type": "custom", "tokenizer": "standard"})),
+		testIndex2: opensearchtools.NewIndexSettings().
+			WithNumberOfShards(1).
+			WithNumberOfReplicas(0).
+			WithAutoExpandReplicas("false").
+			WithRefreshInterval(500 * time.Millisecond).
+			WithMaxResultWindow(10000).
+			WithKNN(true),
+	}, resp.Response.Settings)
+}
+
+func TestExecutor_GetSettingsMissingIndex(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusNotFound, `{
+		"error": {"type": "index_not_found_exception", "reason": "no such index [test_index]", "index": "test_index"},
+		"status": 404
+	}`)
+
+	resp, err := executor.GetSettings(context.Background(), opensearchtools.NewGetSettingsRequest(testIndex1))
+	require.Nil(t, err)
+	require.Nil(t, resp.Response.Settings)
+	require.Equal(t, "index_not_found_exception", resp.Response.Error.Type)
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		duration string
+		want     time.Duration
+		wantErr  bool
+	}{
+		{duration: "10nanos", want: 10 * time.Nanosecond},
+		{duration: "10micros", want: 10 * time.Microsecond},
+		{duration: "10ms", want: 10 * time.Millisecond},
+		{duration: "10s", want: 10 * time.Second},
+		{duration: "10m", want: 10 * time.Minute},
+		{duration: "10h", want: 10 * time.Hour},
+		{duration: "10d", want: 240 * time.Hour},
+		{duration: "10", wantErr: true},
+		{duration: "tens", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.duration, func(t *testing.T) {
+			got, err := parseDuration(tt.duration)
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,456 @@
+package osv2
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// bulkByScrollActions are the task actions whose response is a [BulkByScrollResponse]
+var bulkByScrollActions = []string{
+	"indices:data/write/reindex",
+	"indices:data/write/delete/byquery",
+	"indices:data/write/update/byquery",
+}
+
+// GetTaskRequest is a serializable form of [opensearchtools.GetTaskRequest] specific to
+// the [opensearchapi.TasksGetRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/tasks/
+type GetTaskRequest struct {
+	// TaskID of the task, formatted as node_id:task_number
+	TaskID string
+
+	// WaitForCompletion blocks the request until the task completes
+	WaitForCompletion bool
+
+	// Timeout of the wait for completion. Omitted if zero.
+	Timeout time.Duration
+}
+
+// FromDomainGetTaskRequest creates a new [GetTaskRequest] from the given [opensearchtools.GetTaskRequest].
+func FromDomainGetTaskRequest(req *opensearchtools.GetTaskRequest) (GetTaskRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetTaskRequest{
+		TaskID:            req.TaskID,
+		WaitForCompletion: req.WaitForCompletion,
+		Timeout:           req.Timeout,
+	}, vrs
+}
+
+// Validate validates the given GetTaskRequest
+func (r *GetTaskRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.TaskID == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("TaskID not set on the GetTaskRequest", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [GetTaskRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetTaskResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetTaskRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetTaskResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osReq := opensearchapi.TasksGetRequest{
+		TaskID:  r.TaskID,
+		Timeout: r.Timeout,
+	}
+
+	if r.WaitForCompletion {
+		wait := r.WaitForCompletion
+		osReq.WaitForCompletion = &wait
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	taskResp, err := decodeResponse[GetTaskResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		taskResp,
+	)
+	return &resp, nil
+}
+
+// GetTaskResponse represents the response for a [GetTaskRequest].
+// Error is either the error the task failed with, or the error of the request itself if there is no Task.
+type GetTaskResponse struct {
+	Completed bool            `json:"completed"`
+	Task      *TaskInfo       `json:"task,omitempty"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Error     *Error          `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [GetTaskResponse] into an [opensearchtools.GetTaskResponse].
+// The Response of bulk by scroll operations is also decoded into a BulkByScrollResponse.
+func (r GetTaskResponse) toDomain() opensearchtools.GetTaskResponse {
+	domainResp := opensearchtools.GetTaskResponse{
+		Completed: r.Completed,
+		Response:  r.Response,
+	}
+
+	var domainErr *opensearchtools.Error
+	if r.Error != nil {
+		converted := r.Error.toDomain()
+		domainErr = &converted
+	}
+
+	if r.Task == nil {
+		domainResp.Error = domainErr
+		return domainResp
+	}
+
+	domainResp.Task = r.Task.toDomain()
+	domainResp.TaskError = domainErr
+
+	if len(r.Response) > 0 && isBulkByScrollAction(r.Task.Action) {
+		var bulkByScrollResp BulkByScrollResponse
+		if err := json.Unmarshal(r.Response, &bulkByScrollResp); err == nil {
+			domainBulkByScrollResp := bulkByScrollResp.toDomain()
+			domainResp.BulkByScrollResponse = &domainBulkByScrollResp
+		}
+	}
+
+	return domainResp
+}
+
+// isBulkByScrollAction reports whether the task action is a bulk by scroll operation, or one of its slices
+func isBulkByScrollAction(action string) bool {
+	for _, bulkByScrollAction := range bulkByScrollActions {
+		if strings.HasPrefix(action, bulkByScrollAction) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TaskInfo represents a task in the responses of the tasks API.
+type TaskInfo struct {
+	Node               string            `json:"node"`
+	ID                 int64             `json:"id"`
+	Type               string            `json:"type"`
+	Action             string            `json:"action"`
+	Description        string            `json:"description"`
+	StartTimeInMillis  int64             `json:"start_time_in_millis"`
+	RunningTimeInNanos int64             `json:"running_time_in_nanos"`
+	Cancellable        bool              `json:"cancellable"`
+	Cancelled          bool              `json:"cancelled"`
+	ParentTaskID       string            `json:"parent_task_id,omitempty"`
+	Status             json.RawMessage   `json:"status,omitempty"`
+	Headers            map[string]string `json:"headers,omitempty"`
+}
+
+// toDomain converts this instance of a [TaskInfo] into an [opensearchtools.TaskInfo].
+func (t TaskInfo) toDomain() opensearchtools.TaskInfo {
+	return opensearchtools.TaskInfo{
+		Node:         t.Node,
+		ID:           t.ID,
+		Type:         t.Type,
+		Action:       t.Action,
+		Description:  t.Description,
+		StartTime:    time.UnixMilli(t.StartTimeInMillis),
+		RunningTime:  time.Duration(t.RunningTimeInNanos),
+		Cancellable:  t.Cancellable,
+		Cancelled:    t.Cancelled,
+		ParentTaskID: t.ParentTaskID,
+		Status:       t.Status,
+		Headers:      t.Headers,
+	}
+}
+
+// ListTasksRequest is a serializable form of [opensearchtools.ListTasksRequest] specific to
+// the [opensearchapi.TasksListRequest] in OpenSearch V2.
+// Tasks are listed without grouping.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/tasks/
+type ListTasksRequest struct {
+	// Actions filters the tasks by action, wildcards are supported
+	Actions []string
+
+	// Nodes filters the tasks by the node they run on
+	Nodes []string
+
+	// ParentTaskID filters the tasks by parent task, formatted as node_id:task_number
+	ParentTaskID string
+
+	// Detailed includes the description and status of each task
+	Detailed bool
+}
+
+// FromDomainListTasksRequest creates a new [ListTasksRequest] from the given [opensearchtools.ListTasksRequest].
+func FromDomainListTasksRequest(req *opensearchtools.ListTasksRequest) (ListTasksRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return ListTasksRequest{
+		Actions:      req.Actions,
+		Nodes:        req.Nodes,
+		ParentTaskID: req.ParentTaskID,
+		Detailed:     req.Detailed,
+	}, vrs
+}
+
+// Do executes the [ListTasksRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [ListTasksResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *ListTasksRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[ListTasksResponse], error) {
+	osReq := opensearchapi.TasksListRequest{
+		Actions:      r.Actions,
+		Nodes:        r.Nodes,
+		ParentTaskID: r.ParentTaskID,
+		GroupBy:      "none",
+	}
+
+	if r.Detailed {
+		detailed := r.Detailed
+		osReq.Detailed = &detailed
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	listResp, err := decodeResponse[ListTasksResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		listResp,
+	)
+	return &resp, nil
+}
+
+// ListTasksResponse represents the response for a [ListTasksRequest].
+type ListTasksResponse struct {
+	Tasks        []TaskInfo    `json:"tasks"`
+	NodeFailures []Error       `json:"node_failures,omitempty"`
+	TaskFailures []TaskFailure `json:"task_failures,omitempty"`
+	Error        *Error        `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [ListTasksResponse] into an [opensearchtools.ListTasksResponse].
+func (r ListTasksResponse) toDomain() opensearchtools.ListTasksResponse {
+	domainResp := opensearchtools.ListTasksResponse{
+		NodeFailures: errorsToDomain(r.NodeFailures),
+		TaskFailures: taskFailuresToDomain(r.TaskFailures),
+	}
+
+	for _, task := range r.Tasks {
+		domainResp.Tasks = append(domainResp.Tasks, task.toDomain())
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// CancelTaskRequest is a serializable form of [opensearchtools.CancelTaskRequest] specific to
+// the [opensearchapi.TasksCancelRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/tasks/
+type CancelTaskRequest struct {
+	// TaskID of the task to cancel, formatted as node_id:task_number
+	TaskID string
+
+	// Actions filters the tasks to cancel by action, wildcards are supported
+	Actions []string
+
+	// Nodes filters the tasks to cancel by the node they run on
+	Nodes []string
+
+	// WaitForCompletion blocks the request until the cancelled tasks complete
+	WaitForCompletion bool
+}
+
+// FromDomainCancelTaskRequest creates a new [CancelTaskRequest] from the given [opensearchtools.CancelTaskRequest].
+func FromDomainCancelTaskRequest(req *opensearchtools.CancelTaskRequest) (CancelTaskRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return CancelTaskRequest{
+		TaskID:            req.TaskID,
+		Actions:           req.Actions,
+		Nodes:             req.Nodes,
+		WaitForCompletion: req.WaitForCompletion,
+	}, vrs
+}
+
+// Validate validates the given CancelTaskRequest
+func (r *CancelTaskRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	// without a task ID or filters, every cancellable task of the cluster would be cancelled
+	if r.TaskID == "" && len(r.Actions) == 0 && len(r.Nodes) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("TaskID, Actions or Nodes must be set on the CancelTaskRequest", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [CancelTaskRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [CancelTaskResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *CancelTaskRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[CancelTaskResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osReq := opensearchapi.TasksCancelRequest{
+		TaskID:  r.TaskID,
+		Actions: r.Actions,
+		Nodes:   r.Nodes,
+	}
+
+	if r.WaitForCompletion {
+		wait := r.WaitForCompletion
+		osReq.WaitForCompletion = &wait
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	cancelResp, err := decodeResponse[CancelTaskResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		cancelResp,
+	)
+	return &resp, nil
+}
+
+// CancelTaskResponse represents the response for a [CancelTaskRequest], whose tasks are grouped by node.
+type CancelTaskResponse struct {
+	Nodes        map[string]TaskNode `json:"nodes"`
+	NodeFailures []Error             `json:"node_failures,omitempty"`
+	TaskFailures []TaskFailure       `json:"task_failures,omitempty"`
+	Error        *Error              `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [CancelTaskResponse] into an [opensearchtools.CancelTaskResponse].
+// The tasks of every node are flattened, ordered by node and task ID.
+func (r CancelTaskResponse) toDomain() opensearchtools.CancelTaskResponse {
+	domainResp := opensearchtools.CancelTaskResponse{
+		NodeFailures: errorsToDomain(r.NodeFailures),
+		TaskFailures: taskFailuresToDomain(r.TaskFailures),
+	}
+
+	for _, node := range r.Nodes {
+		for _, task := range node.Tasks {
+			domainResp.Tasks = append(domainResp.Tasks, task.toDomain())
+		}
+	}
+
+	sort.Slice(domainResp.Tasks, func(i, j int) bool {
+		if domainResp.Tasks[i].Node != domainResp.Tasks[j].Node {
+			return domainResp.Tasks[i].Node < domainResp.Tasks[j].Node
+		}
+
+		return domainResp.Tasks[i].ID < domainResp.Tasks[j].ID
+	})
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// TaskNode represents a node and its tasks in the responses of the tasks API grouped by node.
+type TaskNode struct {
+	Name  string              `json:"name"`
+	Tasks map[string]TaskInfo `json:"tasks"`
+}
+
+// TaskFailure represents a failure to act on a task.
+type TaskFailure struct {
+	NodeID string `json:"node_id"`
+	TaskID int64  `json:"task_id"`
+	Status string `json:"status"`
+	Reason Error  `json:"reason"`
+}
+
+// toDomain converts this instance of a [TaskFailure] into an [opensearchtools.TaskFailure].
+func (f TaskFailure) toDomain() opensearchtools.TaskFailure {
+	return opensearchtools.TaskFailure{
+		NodeID: f.NodeID,
+		TaskID: f.TaskID,
+		Status: f.Status,
+		Reason: f.Reason.toDomain(),
+	}
+}
+
+// taskFailuresToDomain converts task failures into [opensearchtools.TaskFailure]s
+func taskFailuresToDomain(failures []TaskFailure) []opensearchtools.TaskFailure {
+	var domainFailures []opensearchtools.TaskFailure
+	for _, failure := range failures {
+		domainFailures = append(domainFailures, failure.toDomain())
+	}
+
+	return domainFailures
+}
+
+// errorsToDomain converts errors into [opensearchtools.Error]s
+func errorsToDomain(errs []Error) []opensearchtools.Error {
+	var domainErrs []opensearchtools.Error
+	for i := range errs {
+		domainErrs = append(domainErrs, errs[i].toDomain())
+	}
+
+	return domainErrs
+}
@@ -0,0 +1,173 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+func TestExecutor_GetTask(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"completed": true,
+		"task": {
+			"node": "node_1", "id": 42, "type": "transport", "action": "indices:data/write/delete/byquery",
+			"status": {"total": 2, "deleted": 2}, "description": "delete-by-query [test_index]",
+			"start_time_in_millis": 1658146048666, "running_time_in_nanos": 1500000,
+			"cancellable": true, "cancelled": false, "headers": {}
+		},
+		"response": {"took": 1, "total": 2, "deleted": 2, "batches": 1, "retries": {"bulk": 0, "search": 0}, "failures": []}
+	}`)
+
+	resp, err := executor.GetTask(context.Background(), opensearchtools.NewGetTaskRequest("node_1:42").WithWaitForCompletion(time.Minute))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_tasks/node_1:42", recorded.Path)
+	require.Equal(t, "true", recorded.Query.Get("wait_for_completion"))
+	require.Equal(t, "60000ms", recorded.Query.Get("timeout"))
+
+	require.True(t, resp.Response.Completed)
+	require.Nil(t, resp.Response.TaskError)
+	require.Equal(t, "node_1:42", resp.Response.Task.TaskID())
+	require.Equal(t, "indices:data/write/delete/byquery", resp.Response.Task.Action)
+	require.Equal(t, time.UnixMilli(1658146048666), resp.Response.Task.StartTime)
+	require.Equal(t, 1500*time.Microsecond, resp.Response.Task.RunningTime)
+	require.JSONEq(t, `{"total": 2, "deleted": 2}`, string(resp.Response.Task.Status))
+	require.Equal(t, &opensearchtools.BulkByScrollResponse{Took: 1, Total: 2, Deleted: 2, Batches: 1}, resp.Response.BulkByScrollResponse)
+}
+
+func TestGetTaskResponse_ToDomainErrors(t *testing.T) {
+	failed := GetTaskResponse{
+		Completed: true,
+		Task:      &TaskInfo{Node: "node_1", ID: 42, Action: "indices:data/write/update/byquery"},
+		Error:     &Error{Type: "search_phase_execution_exception", Reason: "all shards failed"},
+	}
+
+	domainResp := failed.toDomain()
+	require.Nil(t, domainResp.Error)
+	require.Equal(t, "search_phase_execution_exception", domainResp.TaskError.Type)
+	require.Nil(t, domainResp.BulkByScrollResponse)
+
+	missing := GetTaskResponse{
+		Error: &Error{Type: "resource_not_found_exception", Reason: "task [node_1:43] isn't running and hasn't stored its results"},
+	}
+
+	domainResp = missing.toDomain()
+	require.Nil(t, domainResp.TaskError)
+	require.Equal(t, "resource_not_found_exception", domainResp.Error.Type)
+}
+
+func TestGetTaskRequest_Validate(t *testing.T) {
+	req, _ := FromDomainGetTaskRequest(opensearchtools.NewGetTaskRequest(""))
+	vrs := req.Validate()
+	require.True(t, vrs.IsFatal())
+}
+
+func TestExecutor_ListTasks(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"tasks": [
+			{"node": "node_1", "id": 42, "type": "transport", "action": "indices:data/write/reindex", "start_time_in_millis": 1658146048666, "running_time_in_nanos": 1500000, "cancellable": true, "cancelled": false},
+			{"node": "node_1", "id": 43, "type": "transport", "action": "indices:data/write/reindex[s]", "start_time_in_millis": 1658146048667, "running_time_in_nanos": 1000000, "cancellable": true, "cancelled": false, "parent_task_id": "node_1:42"}
+		],
+		"node_failures": [{"type": "failed_node_exception", "reason": "Failed node [node_2]"}]
+	}`)
+
+	req := opensearchtools.NewListTasksRequest().
+		WithActions("*reindex*").
+		WithNodes("node_1", "node_2").
+		WithParentTaskID("node_1:42").
+		WithDetailed(true)
+
+	resp, err := executor.ListTasks(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_tasks", recorded.Path)
+	require.Equal(t, "*reindex*", recorded.Query.Get("actions"))
+	require.Equal(t, "node_1,node_2", recorded.Query.Get("nodes"))
+	require.Equal(t, "node_1:42", recorded.Query.Get("parent_task_id"))
+	require.Equal(t, "true", recorded.Query.Get("detailed"))
+	require.Equal(t, "none", recorded.Query.Get("group_by"))
+
+	require.Len(t, resp.Response.Tasks, 2)
+	require.Equal(t, "node_1:43", resp.Response.Tasks[1].TaskID())
+	require.Equal(t, "node_1:42", resp.Response.Tasks[1].ParentTaskID)
+	require.Len(t, resp.Response.NodeFailures, 1)
+	require.Equal(t, "failed_node_exception", resp.Response.NodeFailures[0].Type)
+}
+
+func TestExecutor_CancelTask(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"nodes": {
+			"node_2": {"name": "node-2", "tasks": {
+				"node_2:7": {"node": "node_2", "id": 7, "action": "indices:data/write/reindex", "cancellable": true, "cancelled": true}
+			}},
+			"node_1": {"name": "node-1", "tasks": {
+				"node_1:43": {"node": "node_1", "id": 43, "action": "indices:data/write/reindex", "cancellable": true, "cancelled": true},
+				"node_1:42": {"node": "node_1", "id": 42, "action": "indices:data/write/reindex", "cancellable": true, "cancelled": true}
+			}}
+		},
+		"task_failures": [{"node_id": "node_3", "task_id": 9, "status": "INTERNAL_SERVER_ERROR", "reason": {"type": "illegal_state_exception", "reason": "task is not cancellable"}}]
+	}`)
+
+	req := opensearchtools.NewCancelTaskRequest("").
+		WithActions("*reindex").
+		WithWaitForCompletion(true)
+
+	resp, err := executor.CancelTask(context.Background(), req)
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPost, recorded.Method)
+	require.Equal(t, "/_tasks/_cancel", recorded.Path)
+	require.Equal(t, "*reindex", recorded.Query.Get("actions"))
+	require.Equal(t, "true", recorded.Query.Get("wait_for_completion"))
+
+	var taskIDs []string
+	for _, task := range resp.Response.Tasks {
+		require.True(t, task.Cancelled)
+		taskIDs = append(taskIDs, task.TaskID())
+	}
+	require.Equal(t, []string{"node_1:42", "node_1:43", "node_2:7"}, taskIDs)
+
+	require.Equal(t, []opensearchtools.TaskFailure{{
+		NodeID: "node_3",
+		TaskID: 9,
+		Status: "INTERNAL_SERVER_ERROR",
+		Reason: opensearchtools.Error{Type: "illegal_state_exception", Reason: "task is not cancellable"},
+	}}, resp.Response.TaskFailures)
+}
+
+func TestCancelTaskRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.CancelTaskRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Task ID",
+			request: opensearchtools.NewCancelTaskRequest("node_1:42"),
+		},
+		{
+			name:    "Filtered by action",
+			request: opensearchtools.NewCancelTaskRequest("").WithActions("*reindex"),
+		},
+		{
+			name:    "Filtered by node",
+			request: opensearchtools.NewCancelTaskRequest("").WithNodes("node_1"),
+		},
+		{
+			name:      "Unfiltered",
+			request:   opensearchtools.NewCancelTaskRequest(""),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainCancelTaskRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
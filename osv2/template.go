@@ -0,0 +1,862 @@
+package osv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// Template is the serializable form of an [opensearchtools.Template] in OpenSearch V2.
+// It is also the body of a [CreateIndexRequest].
+type Template struct {
+	// Settings of the indices
+	Settings *IndexSettings
+
+	// Mapping of the indices
+	Mapping *Mapping
+
+	// Aliases of the indices
+	Aliases []opensearchtools.Alias
+}
+
+// fromDomainTemplate creates a new [Template] from the given [opensearchtools.Template].
+// The alias filters are converted with [V2QueryConverter].
+func fromDomainTemplate(template *opensearchtools.Template) (*Template, error) {
+	if template == nil {
+		return nil, nil
+	}
+
+	aliases, cErr := fromDomainAliases(template.Aliases)
+	if cErr != nil {
+		return nil, cErr
+	}
+
+	return &Template{
+		Settings: FromDomainIndexSettings(template.Settings),
+		Mapping:  FromDomainMapping(template.Mapping),
+		Aliases:  aliases,
+	}, nil
+}
+
+// validate validates the aliases of the Template of the request with the given name
+func (t *Template) validate(requestName string) opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+	if t == nil {
+		return validationResults
+	}
+
+	for _, alias := range t.Aliases {
+		if alias.Name == "" {
+			validationResults.Add(opensearchtools.NewValidationResult("Alias name not set on the "+requestName, true))
+		}
+	}
+
+	return validationResults
+}
+
+// MarshalJSON marshals the Template into the JSON shape expected by OpenSearch,
+// nesting the settings under the index key and the aliases by name.
+func (t *Template) MarshalJSON() ([]byte, error) {
+	source := make(map[string]any)
+
+	if t.Settings != nil {
+		source["settings"] = indexSettingsBody{Index: t.Settings}
+	}
+
+	if t.Mapping != nil {
+		source["mappings"] = t.Mapping
+	}
+
+	if len(t.Aliases) > 0 {
+		aliases := make(map[string]any, len(t.Aliases))
+		for _, alias := range t.Aliases {
+			aliasJSON, jErr := aliasSource(alias)
+			if jErr != nil {
+				return nil, jErr
+			}
+
+			aliases[alias.Name] = aliasJSON
+		}
+
+		source["aliases"] = aliases
+	}
+
+	return json.Marshal(source)
+}
+
+// UnmarshalJSON unmarshals a Template returned by OpenSearch. The aliases are sorted by name.
+func (t *Template) UnmarshalJSON(data []byte) error {
+	var source struct {
+		Settings indexSettingsBody    `json:"settings"`
+		Mappings *Mapping             `json:"mappings"`
+		Aliases  map[string]AliasInfo `json:"aliases"`
+	}
+
+	if err := json.Unmarshal(data, &source); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(source.Aliases))
+	for name := range source.Aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	aliases := make([]opensearchtools.Alias, 0, len(names))
+	for _, name := range names {
+		aliases = append(aliases, *source.Aliases[name].toDomain(name))
+	}
+
+	*t = Template{
+		Settings: source.Settings.Index,
+		Mapping:  source.Mappings,
+		Aliases:  aliases,
+	}
+	return nil
+}
+
+// toDomain converts this instance of a [Template] into an [opensearchtools.Template].
+func (t *Template) toDomain() *opensearchtools.Template {
+	if t == nil {
+		return nil
+	}
+
+	domainTemplate := &opensearchtools.Template{}
+
+	if t.Settings != nil {
+		domainTemplate.Settings = t.Settings.toDomain()
+	}
+
+	if t.Mapping != nil {
+		domainTemplate.Mapping = t.Mapping.toDomain()
+	}
+
+	for i := range t.Aliases {
+		alias := t.Aliases[i]
+		domainTemplate.Aliases = append(domainTemplate.Aliases, &alias)
+	}
+
+	return domainTemplate
+}
+
+// ComponentTemplate is the serializable form of an [opensearchtools.ComponentTemplate] in OpenSearch V2, without its name.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type ComponentTemplate struct {
+	Template *Template      `json:"template"`
+	Version  int            `json:"version,omitempty"`
+	Meta     map[string]any `json:"_meta,omitempty"`
+}
+
+// fromDomainComponentTemplate creates a new [ComponentTemplate] from the given [opensearchtools.ComponentTemplate].
+func fromDomainComponentTemplate(template *opensearchtools.ComponentTemplate) (*ComponentTemplate, error) {
+	if template == nil {
+		return nil, nil
+	}
+
+	converted, cErr := fromDomainTemplate(template.Template)
+	if cErr != nil {
+		return nil, cErr
+	}
+
+	return &ComponentTemplate{
+		Template: converted,
+		Version:  template.Version,
+		Meta:     template.Meta,
+	}, nil
+}
+
+// toDomain converts this instance of a [ComponentTemplate] into an [opensearchtools.ComponentTemplate] with the given name.
+func (t *ComponentTemplate) toDomain(name string) *opensearchtools.ComponentTemplate {
+	return &opensearchtools.ComponentTemplate{
+		Name:     name,
+		Template: t.Template.toDomain(),
+		Version:  t.Version,
+		Meta:     t.Meta,
+	}
+}
+
+// DataStreamTemplate enables data streams on an [IndexTemplate].
+// OpenSearch uses the @timestamp field of the documents as the timestamp field of the data streams.
+type DataStreamTemplate struct{}
+
+// IndexTemplate is the serializable form of an [opensearchtools.IndexTemplate] in OpenSearch V2, without its name.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/
+type IndexTemplate struct {
+	IndexPatterns []string            `json:"index_patterns"`
+	Template      *Template           `json:"template,omitempty"`
+	ComposedOf    []string            `json:"composed_of,omitempty"`
+	Priority      int                 `json:"priority,omitempty"`
+	Version       int                 `json:"version,omitempty"`
+	DataStream    *DataStreamTemplate `json:"data_stream,omitempty"`
+	Meta          map[string]any      `json:"_meta,omitempty"`
+}
+
+// fromDomainIndexTemplate creates a new [IndexTemplate] from the given [opensearchtools.IndexTemplate].
+func fromDomainIndexTemplate(template *opensearchtools.IndexTemplate) (*IndexTemplate, error) {
+	if template == nil {
+		return nil, nil
+	}
+
+	converted, cErr := fromDomainTemplate(template.Template)
+	if cErr != nil {
+		return nil, cErr
+	}
+
+	indexTemplate := &IndexTemplate{
+		IndexPatterns: template.IndexPatterns,
+		Template:      converted,
+		ComposedOf:    template.ComposedOf,
+		Priority:      template.Priority,
+		Version:       template.Version,
+		Meta:          template.Meta,
+	}
+
+	if template.DataStream {
+		indexTemplate.DataStream = &DataStreamTemplate{}
+	}
+
+	return indexTemplate, nil
+}
+
+// validate validates the IndexTemplate of the request with the given name
+func (t *IndexTemplate) validate(requestName string) opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if len(t.IndexPatterns) == 0 {
+		validationResults.Add(opensearchtools.NewValidationResult("IndexPatterns not set on the "+requestName, true))
+	}
+
+	validationResults.Extend(t.Template.validate(requestName))
+
+	return validationResults
+}
+
+// toDomain converts this instance of an [IndexTemplate] into an [opensearchtools.IndexTemplate] with the given name.
+func (t *IndexTemplate) toDomain(name string) *opensearchtools.IndexTemplate {
+	return &opensearchtools.IndexTemplate{
+		Name:          name,
+		IndexPatterns: t.IndexPatterns,
+		Template:      t.Template.toDomain(),
+		ComposedOf:    t.ComposedOf,
+		Priority:      t.Priority,
+		Version:       t.Version,
+		DataStream:    t.DataStream != nil,
+		Meta:          t.Meta,
+	}
+}
+
+// PutComponentTemplateRequest is a serializable form of [opensearchtools.PutComponentTemplateRequest] specific to
+// the [opensearchapi.ClusterPutComponentTemplateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type PutComponentTemplateRequest struct {
+	// Name of the template
+	Name string
+
+	// Template to create or replace
+	Template *ComponentTemplate
+
+	// Create - when true, the request fails if the template already exists
+	Create bool
+}
+
+// FromDomainPutComponentTemplateRequest creates a new [PutComponentTemplateRequest] from the given
+// [opensearchtools.PutComponentTemplateRequest]. The alias filters are converted with [V2QueryConverter].
+func FromDomainPutComponentTemplateRequest(req *opensearchtools.PutComponentTemplateRequest) (PutComponentTemplateRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	putRequest := PutComponentTemplateRequest{
+		Create: req.Create,
+	}
+
+	if req.Template == nil {
+		return putRequest, vrs
+	}
+
+	putRequest.Name = req.Template.Name
+	template, cErr := fromDomainComponentTemplate(req.Template)
+	if cErr != nil {
+		vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+		return putRequest, vrs
+	}
+
+	putRequest.Template = template
+
+	return putRequest, vrs
+}
+
+// Validate validates the given PutComponentTemplateRequest
+func (r *PutComponentTemplateRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Name == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Name not set on the PutComponentTemplateRequest", true))
+	}
+
+	if r.Template == nil || r.Template.Template == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Template not set on the PutComponentTemplateRequest", true))
+	} else {
+		validationResults.Extend(r.Template.Template.validate("PutComponentTemplateRequest"))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the PutComponentTemplateRequest into the JSON shape expected by OpenSearch.
+func (r *PutComponentTemplateRequest) ToOpenSearchJSON() ([]byte, error) {
+	return json.Marshal(r.Template)
+}
+
+// Do executes the [PutComponentTemplateRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *PutComponentTemplateRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.ClusterPutComponentTemplateRequest{
+		Name: r.Name,
+		Body: bytes.NewReader(bodyBytes),
+	}
+
+	if r.Create {
+		osReq.Create = &r.Create
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// GetComponentTemplatesRequest is a serializable form of [opensearchtools.GetComponentTemplatesRequest] specific to
+// the [opensearchapi.ClusterGetComponentTemplateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type GetComponentTemplatesRequest struct {
+	// Names of the templates to get, wildcards are supported. All templates if empty.
+	Names []string
+}
+
+// FromDomainGetComponentTemplatesRequest creates a new [GetComponentTemplatesRequest] from the given
+// [opensearchtools.GetComponentTemplatesRequest].
+func FromDomainGetComponentTemplatesRequest(req *opensearchtools.GetComponentTemplatesRequest) (GetComponentTemplatesRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetComponentTemplatesRequest{
+		Names: req.Names,
+	}, vrs
+}
+
+// Do executes the [GetComponentTemplatesRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetComponentTemplatesResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetComponentTemplatesRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetComponentTemplatesResponse], error) {
+	osResp, rErr := opensearchapi.ClusterGetComponentTemplateRequest{
+		Name: r.Names,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	getResp, err := decodeResponse[GetComponentTemplatesResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		getResp,
+	)
+	return &resp, nil
+}
+
+// NamedComponentTemplate is a [ComponentTemplate] in the response of a [GetComponentTemplatesRequest].
+type NamedComponentTemplate struct {
+	Name              string            `json:"name"`
+	ComponentTemplate ComponentTemplate `json:"component_template"`
+}
+
+// GetComponentTemplatesResponse represents the response for a [GetComponentTemplatesRequest].
+type GetComponentTemplatesResponse struct {
+	ComponentTemplates []NamedComponentTemplate `json:"component_templates"`
+	Error              *Error                   `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [GetComponentTemplatesResponse] into an [opensearchtools.GetComponentTemplatesResponse].
+func (r GetComponentTemplatesResponse) toDomain() opensearchtools.GetComponentTemplatesResponse {
+	var domainResp opensearchtools.GetComponentTemplatesResponse
+
+	for i := range r.ComponentTemplates {
+		named := r.ComponentTemplates[i]
+		domainResp.Templates = append(domainResp.Templates, named.ComponentTemplate.toDomain(named.Name))
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// DeleteComponentTemplateRequest is a serializable form of [opensearchtools.DeleteComponentTemplateRequest] specific to
+// the [opensearchapi.ClusterDeleteComponentTemplateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type DeleteComponentTemplateRequest struct {
+	// Name of the template to delete
+	Name string
+}
+
+// FromDomainDeleteComponentTemplateRequest creates a new [DeleteComponentTemplateRequest] from the given
+// [opensearchtools.DeleteComponentTemplateRequest].
+func FromDomainDeleteComponentTemplateRequest(req *opensearchtools.DeleteComponentTemplateRequest) (DeleteComponentTemplateRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DeleteComponentTemplateRequest{
+		Name: req.Name,
+	}, vrs
+}
+
+// Validate validates the given DeleteComponentTemplateRequest
+func (r *DeleteComponentTemplateRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Name == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Name not set on the DeleteComponentTemplateRequest", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [DeleteComponentTemplateRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeleteComponentTemplateRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.ClusterDeleteComponentTemplateRequest{
+		Name: r.Name,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// PutIndexTemplateRequest is a serializable form of [opensearchtools.PutIndexTemplateRequest] specific to
+// the [opensearchapi.IndicesPutIndexTemplateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/
+type PutIndexTemplateRequest struct {
+	// Name of the template
+	Name string
+
+	// Template to create or replace
+	Template *IndexTemplate
+
+	// Create - when true, the request fails if the template already exists
+	Create bool
+}
+
+// FromDomainPutIndexTemplateRequest creates a new [PutIndexTemplateRequest] from the given
+// [opensearchtools.PutIndexTemplateRequest]. The alias filters are converted with [V2QueryConverter].
+func FromDomainPutIndexTemplateRequest(req *opensearchtools.PutIndexTemplateRequest) (PutIndexTemplateRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	putRequest := PutIndexTemplateRequest{
+		Create: req.Create,
+	}
+
+	if req.Template == nil {
+		return putRequest, vrs
+	}
+
+	putRequest.Name = req.Template.Name
+	template, cErr := fromDomainIndexTemplate(req.Template)
+	if cErr != nil {
+		vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+		return putRequest, vrs
+	}
+
+	putRequest.Template = template
+
+	return putRequest, vrs
+}
+
+// Validate validates the given PutIndexTemplateRequest
+func (r *PutIndexTemplateRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Name == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Name not set on the PutIndexTemplateRequest", true))
+	}
+
+	if r.Template == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Template not set on the PutIndexTemplateRequest", true))
+	} else {
+		validationResults.Extend(r.Template.validate("PutIndexTemplateRequest"))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the PutIndexTemplateRequest into the JSON shape expected by OpenSearch.
+func (r *PutIndexTemplateRequest) ToOpenSearchJSON() ([]byte, error) {
+	return json.Marshal(r.Template)
+}
+
+// Do executes the [PutIndexTemplateRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *PutIndexTemplateRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	bodyBytes, jErr := r.ToOpenSearchJSON()
+	if jErr != nil {
+		return nil, jErr
+	}
+
+	osReq := opensearchapi.IndicesPutIndexTemplateRequest{
+		Name: r.Name,
+		Body: bytes.NewReader(bodyBytes),
+	}
+
+	if r.Create {
+		osReq.Create = &r.Create
+	}
+
+	osResp, rErr := osReq.Do(ctx, client)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// GetIndexTemplatesRequest is a serializable form of [opensearchtools.GetIndexTemplatesRequest] specific to
+// the [opensearchapi.IndicesGetIndexTemplateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/#retrieve-a-template
+type GetIndexTemplatesRequest struct {
+	// Names of the templates to get, wildcards are supported. All templates if empty.
+	Names []string
+}
+
+// FromDomainGetIndexTemplatesRequest creates a new [GetIndexTemplatesRequest] from the given
+// [opensearchtools.GetIndexTemplatesRequest].
+func FromDomainGetIndexTemplatesRequest(req *opensearchtools.GetIndexTemplatesRequest) (GetIndexTemplatesRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return GetIndexTemplatesRequest{
+		Names: req.Names,
+	}, vrs
+}
+
+// Do executes the [GetIndexTemplatesRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [GetIndexTemplatesResponse] will be returned.
+// An error can be returned if
+//
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *GetIndexTemplatesRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[GetIndexTemplatesResponse], error) {
+	osResp, rErr := opensearchapi.IndicesGetIndexTemplateRequest{
+		Name: r.Names,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	getResp, err := decodeResponse[GetIndexTemplatesResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		opensearchtools.NewValidationResults(),
+		osResp.StatusCode,
+		osResp.Header,
+		getResp,
+	)
+	return &resp, nil
+}
+
+// NamedIndexTemplate is an [IndexTemplate] in the response of a [GetIndexTemplatesRequest].
+type NamedIndexTemplate struct {
+	Name          string        `json:"name"`
+	IndexTemplate IndexTemplate `json:"index_template"`
+}
+
+// GetIndexTemplatesResponse represents the response for a [GetIndexTemplatesRequest].
+type GetIndexTemplatesResponse struct {
+	IndexTemplates []NamedIndexTemplate `json:"index_templates"`
+	Error          *Error               `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [GetIndexTemplatesResponse] into an [opensearchtools.GetIndexTemplatesResponse].
+func (r GetIndexTemplatesResponse) toDomain() opensearchtools.GetIndexTemplatesResponse {
+	var domainResp opensearchtools.GetIndexTemplatesResponse
+
+	for i := range r.IndexTemplates {
+		named := r.IndexTemplates[i]
+		domainResp.Templates = append(domainResp.Templates, named.IndexTemplate.toDomain(named.Name))
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
+
+// DeleteIndexTemplateRequest is a serializable form of [opensearchtools.DeleteIndexTemplateRequest] specific to
+// the [opensearchapi.IndicesDeleteIndexTemplateRequest] in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/#delete-a-template
+type DeleteIndexTemplateRequest struct {
+	// Name of the template to delete
+	Name string
+}
+
+// FromDomainDeleteIndexTemplateRequest creates a new [DeleteIndexTemplateRequest] from the given
+// [opensearchtools.DeleteIndexTemplateRequest].
+func FromDomainDeleteIndexTemplateRequest(req *opensearchtools.DeleteIndexTemplateRequest) (DeleteIndexTemplateRequest, opensearchtools.ValidationResults) {
+	// As more versions are implemented, these [opensearchtools.ValidationResults] may be used to contain issues
+	// converting from the domain model to the V2 model.
+	var vrs opensearchtools.ValidationResults
+
+	return DeleteIndexTemplateRequest{
+		Name: req.Name,
+	}, vrs
+}
+
+// Validate validates the given DeleteIndexTemplateRequest
+func (r *DeleteIndexTemplateRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Name == "" {
+		validationResults.Add(opensearchtools.NewValidationResult("Name not set on the DeleteIndexTemplateRequest", true))
+	}
+
+	return validationResults
+}
+
+// Do executes the [DeleteIndexTemplateRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then an [AcknowledgedResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *DeleteIndexTemplateRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[AcknowledgedResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	osResp, rErr := opensearchapi.IndicesDeleteIndexTemplateRequest{
+		Name: r.Name,
+	}.Do(ctx, client)
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return decodeAcknowledgedResponse(osResp, vrs)
+}
+
+// SimulateIndexTemplateRequest is a serializable form of [opensearchtools.SimulateIndexTemplateRequest] specific to
+// the [opensearchapi.IndicesSimulateIndexTemplateRequest] when an index is set,
+// and to the [opensearchapi.IndicesSimulateTemplateRequest] otherwise, in OpenSearch V2.
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/
+type SimulateIndexTemplateRequest struct {
+	// Index whose settings, mappings and aliases to resolve from the existing templates
+	Index string
+
+	// Template to simulate
+	Template *IndexTemplate
+}
+
+// FromDomainSimulateIndexTemplateRequest creates a new [SimulateIndexTemplateRequest] from the given
+// [opensearchtools.SimulateIndexTemplateRequest]. The alias filters are converted with [V2QueryConverter].
+func FromDomainSimulateIndexTemplateRequest(req *opensearchtools.SimulateIndexTemplateRequest) (SimulateIndexTemplateRequest, opensearchtools.ValidationResults) {
+	vrs := opensearchtools.NewValidationResults()
+	simulateRequest := SimulateIndexTemplateRequest{
+		Index: req.Index,
+	}
+
+	template, cErr := fromDomainIndexTemplate(req.Template)
+	if cErr != nil {
+		vrs.Add(opensearchtools.NewValidationResult(cErr.Error(), true))
+		return simulateRequest, vrs
+	}
+
+	simulateRequest.Template = template
+
+	return simulateRequest, vrs
+}
+
+// Validate validates the given SimulateIndexTemplateRequest
+func (r *SimulateIndexTemplateRequest) Validate() opensearchtools.ValidationResults {
+	var validationResults opensearchtools.ValidationResults
+
+	if r.Index == "" && r.Template == nil {
+		validationResults.Add(opensearchtools.NewValidationResult("Index or Template not set on the SimulateIndexTemplateRequest", true))
+	}
+
+	if r.Template != nil {
+		validationResults.Extend(r.Template.validate("SimulateIndexTemplateRequest"))
+	}
+
+	return validationResults
+}
+
+// ToOpenSearchJSON marshals the SimulateIndexTemplateRequest into the JSON shape expected by OpenSearch.
+func (r *SimulateIndexTemplateRequest) ToOpenSearchJSON() ([]byte, error) {
+	return json.Marshal(r.Template)
+}
+
+// Do executes the [SimulateIndexTemplateRequest] using the provided opensearch.Client.
+// If the request is executed successfully, then a [SimulateIndexTemplateResponse] will be returned.
+// An error can be returned if
+//
+//   - Fatal validation issues are found
+//   - The body fails to be marshaled to JSON
+//   - The call to OpenSearch fails
+//   - The result json cannot be unmarshalled
+func (r *SimulateIndexTemplateRequest) Do(ctx context.Context, client *opensearch.Client) (*opensearchtools.OpenSearchResponse[SimulateIndexTemplateResponse], error) {
+	vrs := r.Validate()
+	if vrs.IsFatal() {
+		return nil, opensearchtools.NewValidationError(vrs)
+	}
+
+	var body io.Reader
+	if r.Template != nil {
+		bodyBytes, jErr := r.ToOpenSearchJSON()
+		if jErr != nil {
+			return nil, jErr
+		}
+
+		body = bytes.NewReader(bodyBytes)
+	}
+
+	var (
+		osResp *opensearchapi.Response
+		rErr   error
+	)
+	if r.Index != "" {
+		osResp, rErr = opensearchapi.IndicesSimulateIndexTemplateRequest{
+			Name: r.Index,
+			Body: body,
+		}.Do(ctx, client)
+	} else {
+		osResp, rErr = opensearchapi.IndicesSimulateTemplateRequest{
+			Body: body,
+		}.Do(ctx, client)
+	}
+
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	simulateResp, err := decodeResponse[SimulateIndexTemplateResponse](osResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := opensearchtools.NewOpenSearchResponse(
+		vrs,
+		osResp.StatusCode,
+		osResp.Header,
+		simulateResp,
+	)
+	return &resp, nil
+}
+
+// OverlappingTemplate is a template with a lower priority in a [SimulateIndexTemplateResponse].
+type OverlappingTemplate struct {
+	Name          string   `json:"name"`
+	IndexPatterns []string `json:"index_patterns"`
+}
+
+// SimulateIndexTemplateResponse represents the response for a [SimulateIndexTemplateRequest].
+type SimulateIndexTemplateResponse struct {
+	Template    *Template             `json:"template"`
+	Overlapping []OverlappingTemplate `json:"overlapping"`
+	Error       *Error                `json:"error,omitempty"`
+}
+
+// toDomain converts this instance of a [SimulateIndexTemplateResponse] into an [opensearchtools.SimulateIndexTemplateResponse].
+func (r SimulateIndexTemplateResponse) toDomain() opensearchtools.SimulateIndexTemplateResponse {
+	domainResp := opensearchtools.SimulateIndexTemplateResponse{
+		Template: r.Template.toDomain(),
+	}
+
+	for _, overlapping := range r.Overlapping {
+		domainResp.Overlapping = append(domainResp.Overlapping, opensearchtools.OverlappingTemplate{
+			Name:          overlapping.Name,
+			IndexPatterns: overlapping.IndexPatterns,
+		})
+	}
+
+	if r.Error != nil {
+		domainErr := r.Error.toDomain()
+		domainResp.Error = &domainErr
+	}
+
+	return domainResp
+}
@@ -0,0 +1,317 @@
+package osv2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/opensearchtools"
+)
+
+// testTemplate builds a template with settings, a mapping and an alias
+func testTemplate() *opensearchtools.Template {
+	return opensearchtools.NewTemplate().
+		WithSettings(opensearchtools.NewIndexSettings().WithNumberOfShards(2).WithRefreshInterval(5 * time.Second)).
+		WithMapping(opensearchtools.NewMapping().AddProperty("title", opensearchtools.NewKeywordProperty())).
+		AddAliases(opensearchtools.NewAlias("logs").WithFilter(opensearchtools.NewTermQuery("level", "error")))
+}
+
+// testTemplateJSON is the OpenSearch JSON of testTemplate
+const testTemplateJSON = `{
+	"settings": {"index": {"number_of_shards": "2", "refresh_interval": "5000ms"}},
+	"mappings": {"properties": {"title": {"type": "keyword"}}},
+	"aliases": {"logs": {"filter": {"term": {"level": "error"}}}}
+}`
+
+func TestPutIndexTemplateRequest_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *opensearchtools.IndexTemplate
+		want     string
+	}{
+		{
+			name:     "Index patterns only",
+			template: opensearchtools.NewIndexTemplate("logs", "logs-*"),
+			want:     `{"index_patterns": ["logs-*"]}`,
+		},
+		{
+			name: "All fields",
+			template: opensearchtools.NewIndexTemplate("logs", "logs-*", "audit-*").
+				WithTemplate(testTemplate()).
+				AddComposedOf("logs_settings", "logs_mappings").
+				WithPriority(100).
+				WithVersion(3).
+				WithDataStream(true).
+				WithMeta(map[string]any{"owner": "search"}),
+			want: `{
+				"index_patterns": ["logs-*", "audit-*"],
+				"template": ` + testTemplateJSON + `,
+				"composed_of": ["logs_settings", "logs_mappings"],
+				"priority": 100,
+				"version": 3,
+				"data_stream": {},
+				"_meta": {"owner": "search"}
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, vrs := FromDomainPutIndexTemplateRequest(opensearchtools.NewPutIndexTemplateRequest(tt.template))
+			require.False(t, vrs.IsFatal())
+
+			got, err := req.ToOpenSearchJSON()
+			require.Nil(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestPutIndexTemplateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.PutIndexTemplateRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewPutIndexTemplateRequest(opensearchtools.NewIndexTemplate("logs", "logs-*")),
+		},
+		{
+			name:      "Missing template",
+			request:   opensearchtools.NewPutIndexTemplateRequest(nil),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing name",
+			request:   opensearchtools.NewPutIndexTemplateRequest(opensearchtools.NewIndexTemplate("", "logs-*")),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing index patterns",
+			request:   opensearchtools.NewPutIndexTemplateRequest(opensearchtools.NewIndexTemplate("logs")),
+			wantFatal: true,
+		},
+		{
+			name: "Missing alias name",
+			request: opensearchtools.NewPutIndexTemplateRequest(opensearchtools.NewIndexTemplate("logs", "logs-*").
+				WithTemplate(opensearchtools.NewTemplate().AddAliases(opensearchtools.NewAlias("")))),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainPutIndexTemplateRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestPutComponentTemplateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *opensearchtools.PutComponentTemplateRequest
+		wantFatal bool
+	}{
+		{
+			name:    "Valid request",
+			request: opensearchtools.NewPutComponentTemplateRequest(opensearchtools.NewComponentTemplate("logs_settings", opensearchtools.NewTemplate())),
+		},
+		{
+			name:      "Missing component template",
+			request:   opensearchtools.NewPutComponentTemplateRequest(nil),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing name",
+			request:   opensearchtools.NewPutComponentTemplateRequest(opensearchtools.NewComponentTemplate("", opensearchtools.NewTemplate())),
+			wantFatal: true,
+		},
+		{
+			name:      "Missing template",
+			request:   opensearchtools.NewPutComponentTemplateRequest(opensearchtools.NewComponentTemplate("logs_settings", nil)),
+			wantFatal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := FromDomainPutComponentTemplateRequest(tt.request)
+			vrs := req.Validate()
+			require.Equal(t, tt.wantFatal, vrs.IsFatal())
+		})
+	}
+}
+
+func TestExecutor_PutComponentTemplate(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	template := opensearchtools.NewComponentTemplate("logs_settings", testTemplate()).WithVersion(2)
+	resp, err := executor.PutComponentTemplate(context.Background(), opensearchtools.NewPutComponentTemplateRequest(template).WithCreate(true))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, recorded.Method)
+	require.Equal(t, "/_component_template/logs_settings", recorded.Path)
+	require.Equal(t, "true", recorded.Query.Get("create"))
+	require.JSONEq(t, `{"template": `+testTemplateJSON+`, "version": 2}`, string(recorded.Body))
+	require.True(t, resp.Response.Acknowledged)
+}
+
+func TestExecutor_GetComponentTemplates(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"component_templates": [
+			{
+				"name": "logs_settings",
+				"component_template": {
+					"template": {
+						"settings": {"index": {"number_of_shards": "2", "refresh_interval": "5s"}},
+						"aliases": {"logs": {}, "errors": {"filter": {"term": {"level": "error"}}}}
+					},
+					"version": 2,
+					"_meta": {"owner": "search"}
+				}
+			},
+			{
+				"name": "logs_mappings",
+				"component_template": {"template": {"mappings": {"properties": {"title": {"type": "keyword"}}}}}
+			}
+		]
+	}`)
+
+	resp, err := executor.GetComponentTemplates(context.Background(), opensearchtools.NewGetComponentTemplatesRequest("logs_*"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_component_template/logs_*", recorded.Path)
+
+	require.Nil(t, resp.Response.Error)
+	require.Equal(t, []*opensearchtools.ComponentTemplate{
+		opensearchtools.NewComponentTemplate("logs_settings", opensearchtools.NewTemplate().
+			WithSettings(opensearchtools.NewIndexSettings().WithNumberOfShards(2).WithRefreshInterval(5*time.Second)).
+			AddAliases(
+				opensearchtools.NewAlias("errors").WithFilter(opensearchtools.NewRawQuery([]byte(`{"term": {"level": "error"}}`))),
+				opensearchtools.NewAlias("logs"),
+			)).
+			WithVersion(2).
+			WithMeta(map[string]any{"owner": "search"}),
+		opensearchtools.NewComponentTemplate("logs_mappings", opensearchtools.NewTemplate().
+			WithMapping(opensearchtools.NewMapping().AddProperty("title", opensearchtools.NewKeywordProperty()))),
+	}, resp.Response.Templates)
+}
+
+func TestExecutor_GetIndexTemplates(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{
+		"index_templates": [
+			{
+				"name": "logs",
+				"index_template": {
+					"index_patterns": ["logs-*"],
+					"template": {"settings": {"index": {"number_of_replicas": "1"}}},
+					"composed_of": ["logs_settings"],
+					"priority": 100,
+					"data_stream": {"timestamp_field": {"name": "@timestamp"}}
+				}
+			}
+		]
+	}`)
+
+	resp, err := executor.GetIndexTemplates(context.Background(), opensearchtools.NewGetIndexTemplatesRequest("logs"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, recorded.Method)
+	require.Equal(t, "/_index_template/logs", recorded.Path)
+
+	require.Nil(t, resp.Response.Error)
+	require.Equal(t, []*opensearchtools.IndexTemplate{
+		opensearchtools.NewIndexTemplate("logs", "logs-*").
+			WithTemplate(opensearchtools.NewTemplate().WithSettings(opensearchtools.NewIndexSettings().WithNumberOfReplicas(1))).
+			AddComposedOf("logs_settings").
+			WithPriority(100).
+			WithDataStream(true),
+	}, resp.Response.Templates)
+}
+
+func TestExecutor_GetIndexTemplatesMissing(t *testing.T) {
+	executor, _ := newRecordingExecutor(t, http.StatusNotFound, `{
+		"error": {"type": "resource_not_found_exception", "reason": "index template matching [logs] not found"},
+		"status": 404
+	}`)
+
+	resp, err := executor.GetIndexTemplates(context.Background(), opensearchtools.NewGetIndexTemplatesRequest("logs"))
+	require.Nil(t, err)
+	require.Empty(t, resp.Response.Templates)
+	require.Equal(t, "resource_not_found_exception", resp.Response.Error.Type)
+}
+
+func TestExecutor_DeleteTemplates(t *testing.T) {
+	executor, recorded := newRecordingExecutor(t, http.StatusOK, `{"acknowledged": true}`)
+
+	resp, err := executor.DeleteIndexTemplate(context.Background(), opensearchtools.NewDeleteIndexTemplateRequest("logs"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, recorded.Method)
+	require.Equal(t, "/_index_template/logs", recorded.Path)
+	require.True(t, resp.Response.Acknowledged)
+
+	resp, err = executor.DeleteComponentTemplate(context.Background(), opensearchtools.NewDeleteComponentTemplateRequest("logs_settings"))
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, recorded.Method)
+	require.Equal(t, "/_component_template/logs_settings", recorded.Path)
+	require.True(t, resp.Response.Acknowledged)
+
+	_, err = executor.DeleteIndexTemplate(context.Background(), opensearchtools.NewDeleteIndexTemplateRequest(""))
+	require.NotNil(t, err)
+}
+
+func TestExecutor_SimulateIndexTemplate(t *testing.T) {
+	simulated := `{
+		"template": {
+			"settings": {"index": {"number_of_shards": "2"}},
+			"mappings": {"properties": {"title": {"type": "keyword"}}},
+			"aliases": {"logs": {}}
+		},
+		"overlapping": [{"name": "catch_all", "index_patterns": ["*"]}]
+	}`
+
+	tests := []struct {
+		name     string
+		request  *opensearchtools.SimulateIndexTemplateRequest
+		wantPath string
+		wantBody string
+	}{
+		{
+			name:     "Index",
+			request:  opensearchtools.NewSimulateIndexTemplateRequest().WithIndex("logs-2023.01.01"),
+			wantPath: "/_index_template/_simulate_index/logs-2023.01.01",
+		},
+		{
+			name:     "Template",
+			request:  opensearchtools.NewSimulateIndexTemplateRequest().WithTemplate(opensearchtools.NewIndexTemplate("logs", "logs-*").AddComposedOf("logs_settings")),
+			wantPath: "/_index_template/_simulate",
+			wantBody: `{"index_patterns": ["logs-*"], "composed_of": ["logs_settings"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor, recorded := newRecordingExecutor(t, http.StatusOK, simulated)
+
+			resp, err := executor.SimulateIndexTemplate(context.Background(), tt.request)
+			require.Nil(t, err)
+			require.Equal(t, http.MethodPost, recorded.Method)
+			require.Equal(t, tt.wantPath, recorded.Path)
+			if tt.wantBody == "" {
+				require.Empty(t, recorded.Body)
+			} else {
+				require.JSONEq(t, tt.wantBody, string(recorded.Body))
+			}
+
+			require.Equal(t, opensearchtools.NewTemplate().
+				WithSettings(opensearchtools.NewIndexSettings().WithNumberOfShards(2)).
+				WithMapping(opensearchtools.NewMapping().AddProperty("title", opensearchtools.NewKeywordProperty())).
+				AddAliases(opensearchtools.NewAlias("logs")), resp.Response.Template)
+			require.Equal(t, []opensearchtools.OverlappingTemplate{{Name: "catch_all", IndexPatterns: []string{"*"}}}, resp.Response.Overlapping)
+		})
+	}
+
+	executor, _ := newRecordingExecutor(t, http.StatusOK, simulated)
+	_, err := executor.SimulateIndexTemplate(context.Background(), opensearchtools.NewSimulateIndexTemplateRequest())
+	require.NotNil(t, err)
+}
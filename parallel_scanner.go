@@ -0,0 +1,173 @@
+package opensearchtools
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// DefaultParallelScannerBufferSize is the number of hits buffered by a [ParallelScanner] before slices block
+// waiting for the consumer.
+const DefaultParallelScannerBufferSize = 1000
+
+// ParallelScanner scans all the hits of a point-in-time [SearchRequest] by splitting it into slices,
+// each one paged through by a [SearchIterator] in its own goroutine.
+// The hits of every slice are merged into a single channel, in no particular order.
+//
+//	scanner := NewParallelScanner(osv2Executor, NewSearchRequest().
+//		WithSize(1000).
+//		WithPointInTime(pitID, time.Minute), 4)
+//	hits, wait := scanner.Scan(ctx)
+//	for hit := range hits {
+//		...
+//	}
+//	if err := wait(); err != nil {
+//		...
+//	}
+//
+// The channel is buffered, slices block once the buffer is full until the consumer catches up.
+// The first slice to fail cancels the others.
+type ParallelScanner struct {
+	client     Search
+	req        SearchRequest
+	slices     int
+	field      string
+	bufferSize int
+	tiebreaker Sort
+}
+
+// NewParallelScanner instantiates a ParallelScanner splitting the request into the given number of slices.
+// The request must search a [PointInTime], as OpenSearch only slices scroll and point-in-time searches.
+// The request is copied, so changes made to it after creating the scanner have no effect.
+func NewParallelScanner(client Search, req *SearchRequest, slices int) *ParallelScanner {
+	return &ParallelScanner{
+		client:     client,
+		req:        *req,
+		slices:     slices,
+		bufferSize: DefaultParallelScannerBufferSize,
+		tiebreaker: DefaultSearchIteratorTiebreaker,
+	}
+}
+
+// WithSliceField sets the field used to split the documents into slices, _id by default.
+func (s *ParallelScanner) WithSliceField(field string) *ParallelScanner {
+	s.field = field
+	return s
+}
+
+// WithBufferSize sets the number of hits buffered before slices wait for the consumer.
+func (s *ParallelScanner) WithBufferSize(n int) *ParallelScanner {
+	s.bufferSize = n
+	return s
+}
+
+// WithTiebreaker replaces the tiebreaker sort of the [SearchIterator] of each slice.
+func (s *ParallelScanner) WithTiebreaker(tiebreaker Sort) *ParallelScanner {
+	s.tiebreaker = tiebreaker
+	return s
+}
+
+// Scan starts scanning every slice, returning the channel receiving their hits and a function waiting for all
+// the slices to stop.
+// The channel is closed once every slice has been scanned, or after the first slice fails.
+// The wait function returns the error of the first slice to fail.
+// To stop scanning early, cancel ctx before calling the wait function.
+func (s *ParallelScanner) Scan(ctx context.Context) (<-chan Hit, func() error) {
+	hits := make(chan Hit, s.bufferSize)
+
+	if s.req.PointInTime == nil {
+		close(hits)
+		return hits, func() error {
+			return errors.New("a parallel scan requires a PointInTime")
+		}
+	}
+
+	if s.slices < 1 {
+		close(hits)
+		return hits, func() error {
+			return errors.New("a parallel scan requires at least one slice")
+		}
+	}
+
+	scanCtx, cancel := context.WithCancel(ctx)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	for id := 0; id < s.slices; id++ {
+		sliceReq := s.req
+		// OpenSearch rejects a single slice, the whole request is the only slice
+		if s.slices > 1 {
+			sliceReq.Slice = &Slice{
+				ID:    id,
+				Max:   s.slices,
+				Field: s.field,
+			}
+		}
+
+		iter := NewSearchIterator(s.client, &sliceReq).WithTiebreaker(s.tiebreaker)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := scanSlice(scanCtx, iter, hits); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		cancel()
+		close(hits)
+		close(done)
+	}()
+
+	return hits, func() error {
+		<-done
+		return firstErr
+	}
+}
+
+// scanSlice sends every hit of the iterator to hits, until the iterator is done or ctx is.
+func scanSlice(ctx context.Context, iter *SearchIterator, hits chan<- Hit) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page, err := iter.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if sErr := sendHits(ctx, page.Response.Hits.Hits, hits); sErr != nil {
+			return sErr
+		}
+	}
+}
+
+// sendHits sends each hit to the channel, unless ctx is done first.
+func sendHits(ctx context.Context, pageHits []Hit, hits chan<- Hit) error {
+	for _, hit := range pageHits {
+		select {
+		case hits <- hit:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package opensearchtools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// slicedSearch serves numDocs hits, assigning each document to the slice of its position modulo the slice max.
+// It fails every search of the slice failSlice if set.
+type slicedSearch struct {
+	numDocs   int
+	failSlice *int
+
+	mu       sync.Mutex
+	requests []SearchRequest
+}
+
+func (s *slicedSearch) Search(ctx context.Context, req *SearchRequest) (OpenSearchResponse[SearchResponse], error) {
+	s.mu.Lock()
+	s.requests = append(s.requests, *req)
+	s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return OpenSearchResponse[SearchResponse]{}, err
+	}
+
+	sliceID, sliceMax := 0, 1
+	if req.Slice != nil {
+		sliceID, sliceMax = req.Slice.ID, req.Slice.Max
+	}
+
+	if s.failSlice != nil && *s.failSlice == sliceID {
+		return OpenSearchResponse[SearchResponse]{}, errors.New("slice failed")
+	}
+
+	start := 0
+	if len(req.SearchAfter) > 0 {
+		start = req.SearchAfter[0].(int) + 1
+	}
+
+	var resp SearchResponse
+	for i := start; i < s.numDocs && len(resp.Hits.Hits) < req.Size; i++ {
+		if i%sliceMax == sliceID {
+			resp.Hits.Hits = append(resp.Hits.Hits, Hit{Index: testIndex1, ID: fmt.Sprint(i), Sort: []any{i}})
+		}
+	}
+
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, resp), nil
+}
+
+func TestParallelScanner_Scan(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices int
+	}{
+		{
+			name:   "Single slice",
+			slices: 1,
+		},
+		{
+			name:   "Multiple slices",
+			slices: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &slicedSearch{numDocs: 20}
+			req := NewSearchRequest().WithSize(4).WithPointInTime("pit_1", time.Minute)
+			hits, wait := NewParallelScanner(client, req, tt.slices).
+				WithSliceField("timestamp").
+				WithBufferSize(1).
+				Scan(context.Background())
+
+			var ids []string
+			for hit := range hits {
+				ids = append(ids, hit.ID)
+			}
+
+			require.Nil(t, wait())
+
+			var wantIDs []string
+			for i := 0; i < client.numDocs; i++ {
+				wantIDs = append(wantIDs, fmt.Sprint(i))
+			}
+
+			require.ElementsMatch(t, wantIDs, ids)
+
+			for _, searched := range client.requests {
+				if tt.slices == 1 {
+					require.Nil(t, searched.Slice)
+					continue
+				}
+
+				require.Equal(t, tt.slices, searched.Slice.Max)
+				require.Equal(t, "timestamp", searched.Slice.Field)
+			}
+		})
+	}
+}
+
+func TestParallelScanner_FirstError(t *testing.T) {
+	failSlice := 1
+	client := &slicedSearch{numDocs: 1000, failSlice: &failSlice}
+	req := NewSearchRequest().WithSize(2).WithPointInTime("pit_1", time.Minute)
+
+	hits, wait := NewParallelScanner(client, req, 3).WithBufferSize(0).Scan(context.Background())
+	for range hits {
+	}
+
+	err := wait()
+	require.NotNil(t, err)
+	require.Equal(t, "slice failed", err.Error())
+}
+
+func TestParallelScanner_Canceled(t *testing.T) {
+	client := &slicedSearch{numDocs: 1000}
+	req := NewSearchRequest().WithSize(2).WithPointInTime("pit_1", time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hits, wait := NewParallelScanner(client, req, 2).WithBufferSize(0).Scan(ctx)
+	<-hits
+	cancel()
+
+	require.True(t, errors.Is(wait(), context.Canceled))
+}
+
+func TestParallelScanner_Invalid(t *testing.T) {
+	client := &slicedSearch{numDocs: 10}
+
+	hits, wait := NewParallelScanner(client, NewSearchRequest(), 2).Scan(context.Background())
+	_, open := <-hits
+	require.False(t, open)
+	require.NotNil(t, wait())
+
+	hits, wait = NewParallelScanner(client, NewSearchRequest().WithPointInTime("pit_1", time.Minute), 0).Scan(context.Background())
+	_, open = <-hits
+	require.False(t, open)
+	require.NotNil(t, wait())
+	require.Empty(t, client.requests)
+}
@@ -0,0 +1,178 @@
+package opensearchtools
+
+import (
+	"context"
+	"time"
+)
+
+// CreatePIT defines a method which knows how to make an OpenSearch [Create PIT] request.
+// It should be implemented by a version-specific executor.
+//
+// [Create PIT]: https://opensearch.org/docs/latest/search-plugins/point-in-time-api/#create-a-pit
+type CreatePIT interface {
+	CreatePIT(ctx context.Context, req *CreatePITRequest) (OpenSearchResponse[CreatePITResponse], error)
+}
+
+// DeletePIT defines a method which knows how to make an OpenSearch [Delete PIT] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete PIT]: https://opensearch.org/docs/latest/search-plugins/point-in-time-api/#delete-pits
+type DeletePIT interface {
+	DeletePIT(ctx context.Context, req *DeletePITRequest) (OpenSearchResponse[DeletePITResponse], error)
+}
+
+// ListPITs defines a method which knows how to make an OpenSearch [List all PITs] request.
+// It should be implemented by a version-specific executor.
+//
+// [List all PITs]: https://opensearch.org/docs/latest/search-plugins/point-in-time-api/#list-all-pits
+type ListPITs interface {
+	ListPITs(ctx context.Context) (OpenSearchResponse[ListPITsResponse], error)
+}
+
+// CreatePITRequest is a domain model union type for all the fields of a Create PIT request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2.4+
+//
+// This CreatePITRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	pitReq := NewCreatePITRequest(time.Hour, "example_index")
+//	pitResp, err := osv2Executor.CreatePIT(ctx, pitReq)
+//	searchReq := NewSearchRequest().WithPointInTime(pitResp.Response.PITID, time.Hour)
+type CreatePITRequest struct {
+	// Indices the PIT is created for
+	Indices []string
+
+	// KeepAlive is how long the PIT is kept alive
+	KeepAlive time.Duration
+
+	// Routing value used to route the request to a specific shard
+	Routing string
+
+	// Preference of the nodes or shards the request is performed on
+	Preference string
+
+	// ExpandWildcards determines the types of indices wildcard expressions can match, such as "open" or "all"
+	ExpandWildcards string
+
+	// AllowPartialPITCreation determines if a PIT is created when some of the shards are unavailable
+	AllowPartialPITCreation *bool
+}
+
+// NewCreatePITRequest instantiates a CreatePITRequest for the indices kept alive for keepAlive.
+func NewCreatePITRequest(keepAlive time.Duration, indices ...string) *CreatePITRequest {
+	return &CreatePITRequest{
+		Indices:   indices,
+		KeepAlive: keepAlive,
+	}
+}
+
+// WithRouting sets the routing value
+func (r *CreatePITRequest) WithRouting(routing string) *CreatePITRequest {
+	r.Routing = routing
+	return r
+}
+
+// WithPreference sets the preference of the nodes or shards the request is performed on
+func (r *CreatePITRequest) WithPreference(preference string) *CreatePITRequest {
+	r.Preference = preference
+	return r
+}
+
+// WithExpandWildcards sets the types of indices wildcard expressions can match
+func (r *CreatePITRequest) WithExpandWildcards(expandWildcards string) *CreatePITRequest {
+	r.ExpandWildcards = expandWildcards
+	return r
+}
+
+// WithAllowPartialPITCreation sets whether a PIT is created when some of the shards are unavailable
+func (r *CreatePITRequest) WithAllowPartialPITCreation(allow bool) *CreatePITRequest {
+	r.AllowPartialPITCreation = &allow
+	return r
+}
+
+// CreatePITResponse is a domain model union response type for CreatePITRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2.4+
+type CreatePITResponse struct {
+	// PITID identifies the PIT in a [SearchRequest]
+	PITID string
+
+	// Shards [ShardMeta] counts of the shards the PIT was created on
+	Shards ShardMeta
+
+	// CreationTime of the PIT in epoch milliseconds
+	CreationTime int64
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
+
+// DeletePITRequest is a domain model union type for all the fields of a Delete PIT request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2.4+
+//
+// Either PITIDs or All must be set.
+type DeletePITRequest struct {
+	// PITIDs to be deleted
+	PITIDs []string
+
+	// All deletes every PIT of the cluster, PITIDs are ignored
+	All bool
+}
+
+// NewDeletePITRequest instantiates a DeletePITRequest for the PIT IDs.
+func NewDeletePITRequest(pitIDs ...string) *DeletePITRequest {
+	return &DeletePITRequest{
+		PITIDs: pitIDs,
+	}
+}
+
+// NewDeleteAllPITsRequest instantiates a DeletePITRequest deleting every PIT of the cluster.
+func NewDeleteAllPITsRequest() *DeletePITRequest {
+	return &DeletePITRequest{
+		All: true,
+	}
+}
+
+// DeletePITResponse is a domain model union response type for DeletePITRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2.4+
+type DeletePITResponse struct {
+	// PITs lists the outcome of the deletion of each PIT
+	PITs []DeletedPIT
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
+
+// DeletedPIT is the outcome of the deletion of a single PIT.
+type DeletedPIT struct {
+	PITID      string
+	Successful bool
+}
+
+// ListPITsResponse is a domain model union response type for listing all PITs for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2.4+
+type ListPITsResponse struct {
+	// PITs are the PITs of the cluster
+	PITs []PITInfo
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
+
+// PITInfo describes an existing PIT.
+type PITInfo struct {
+	// PITID identifies the PIT in a [SearchRequest]
+	PITID string
+
+	// CreationTime of the PIT in epoch milliseconds
+	CreationTime int64
+
+	// KeepAlive is how long the PIT is kept alive
+	KeepAlive time.Duration
+}
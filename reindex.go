@@ -0,0 +1,237 @@
+package opensearchtools
+
+import (
+	"context"
+	"time"
+)
+
+// Reindex defines a method which knows how to make an OpenSearch [Reindex] request.
+// It should be implemented by a version-specific executor.
+//
+// [Reindex]: https://opensearch.org/docs/latest/api-reference/document-apis/reindex/
+type Reindex interface {
+	Reindex(ctx context.Context, req *ReindexRequest) (OpenSearchResponse[BulkByScrollResponse], error)
+}
+
+// OpType determines how documents are written to an index when they may already exist
+type OpType string
+
+const (
+	// OpTypeIndex - default, documents are created or overwritten
+	OpTypeIndex OpType = "index"
+	// OpTypeCreate - only missing documents are created, existing documents are version conflicts
+	OpTypeCreate OpType = "create"
+)
+
+// ReindexRequest is a domain model union type for all the fields of a Reindex request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This ReindexRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	reindexReq := NewReindexRequest("new_index", "old_index").
+//		WithQuery(NewRangeQuery("timestamp").Gte("now-7d")).
+//		WithScript(NewScript("ctx._source.remove('deprecated_field')")).
+//		WithWaitForCompletion(false)
+//	reindexResp, err := osv2Executor.Reindex(ctx, reindexReq)
+//
+// When not waiting for completion, the response only contains the Task to be polled with a [GetTaskRequest].
+type ReindexRequest struct {
+	// Source of the documents to copy
+	Source ReindexSource
+
+	// Dest is the destination of the documents
+	Dest ReindexDest
+
+	// Script transforming each document before it is written to the destination
+	Script *Script
+
+	// Conflicts determines what happens on a version conflict, ConflictsAbort if empty
+	Conflicts Conflicts
+
+	// Slices splits the operation into parallel sub-tasks, or SlicesAuto. Omitted if zero.
+	Slices int
+
+	// RequestsPerSecond throttles the operation, -1 disables throttling. Omitted if zero.
+	RequestsPerSecond int
+
+	// MaxDocs is the maximum number of documents to copy. Omitted if zero.
+	MaxDocs int
+
+	// Refresh the destination once the operation completes
+	Refresh bool
+
+	// WaitForCompletion - when false, the operation runs as a task whose ID is returned. Defaults to true if nil.
+	WaitForCompletion *bool
+}
+
+// ReindexSource is the source of the documents of a [ReindexRequest].
+type ReindexSource struct {
+	// Index(s) to copy documents from
+	Index []string
+
+	// Query matching the documents to copy, all documents if nil
+	Query Query
+
+	// Size of the batches of documents copied. Omitted if zero.
+	Size int
+
+	// Sort(s) the documents are copied in, which is mostly useful along with MaxDocs
+	Sort []Sort
+
+	// Remote cluster the documents are copied from, the local cluster if nil
+	Remote *ReindexRemote
+}
+
+// ReindexRemote is a remote cluster documents are copied from.
+// The host must be allowed by the reindex.remote.allowlist setting of the destination cluster.
+type ReindexRemote struct {
+	// Host of the remote cluster, including the scheme and port, such as https://otherhost:9200
+	Host string
+
+	// Username to authenticate with
+	Username string
+
+	// Password to authenticate with
+	Password string
+
+	// Headers sent with each request to the remote cluster
+	Headers map[string]string
+
+	// SocketTimeout is the timeout of reads from the remote cluster. Omitted if zero.
+	SocketTimeout time.Duration
+
+	// ConnectTimeout is the timeout of connections to the remote cluster. Omitted if zero.
+	ConnectTimeout time.Duration
+}
+
+// ReindexDest is the destination of the documents of a [ReindexRequest].
+type ReindexDest struct {
+	// Index to copy documents to
+	Index string
+
+	// OpType determines whether existing documents are overwritten, OpTypeIndex if empty
+	OpType OpType
+
+	// Pipeline the documents are ingested with
+	Pipeline string
+}
+
+// NewReindexRequest instantiates a ReindexRequest copying all the documents of the source indices to the dest index.
+func NewReindexRequest(dest string, sources ...string) *ReindexRequest {
+	return &ReindexRequest{
+		Source: ReindexSource{Index: sources},
+		Dest:   ReindexDest{Index: dest},
+	}
+}
+
+// WithQuery sets the query matching the documents to copy
+func (r *ReindexRequest) WithQuery(q Query) *ReindexRequest {
+	r.Source.Query = q
+	return r
+}
+
+// WithSize sets the size of the batches of documents copied
+func (r *ReindexRequest) WithSize(n int) *ReindexRequest {
+	r.Source.Size = n
+	return r
+}
+
+// AddSorts to the current list of [Sort]s the documents are copied in
+func (r *ReindexRequest) AddSorts(sort ...Sort) *ReindexRequest {
+	r.Source.Sort = append(r.Source.Sort, sort...)
+	return r
+}
+
+// WithRemote copies the documents from a remote cluster
+func (r *ReindexRequest) WithRemote(remote *ReindexRemote) *ReindexRequest {
+	r.Source.Remote = remote
+	return r
+}
+
+// WithOpType sets whether existing documents of the destination are overwritten
+func (r *ReindexRequest) WithOpType(opType OpType) *ReindexRequest {
+	r.Dest.OpType = opType
+	return r
+}
+
+// WithPipeline sets the pipeline documents are ingested with
+func (r *ReindexRequest) WithPipeline(pipeline string) *ReindexRequest {
+	r.Dest.Pipeline = pipeline
+	return r
+}
+
+// WithScript sets the script transforming each document
+func (r *ReindexRequest) WithScript(script *Script) *ReindexRequest {
+	r.Script = script
+	return r
+}
+
+// WithConflicts sets what happens on a version conflict
+func (r *ReindexRequest) WithConflicts(conflicts Conflicts) *ReindexRequest {
+	r.Conflicts = conflicts
+	return r
+}
+
+// WithSlices splits the operation into n parallel sub-tasks, or SlicesAuto
+func (r *ReindexRequest) WithSlices(n int) *ReindexRequest {
+	r.Slices = n
+	return r
+}
+
+// WithRequestsPerSecond throttles the operation, -1 disables throttling
+func (r *ReindexRequest) WithRequestsPerSecond(n int) *ReindexRequest {
+	r.RequestsPerSecond = n
+	return r
+}
+
+// WithMaxDocs sets the maximum number of documents to copy
+func (r *ReindexRequest) WithMaxDocs(n int) *ReindexRequest {
+	r.MaxDocs = n
+	return r
+}
+
+// WithRefresh sets whether the destination is refreshed once the operation completes
+func (r *ReindexRequest) WithRefresh(refresh bool) *ReindexRequest {
+	r.Refresh = refresh
+	return r
+}
+
+// WithWaitForCompletion sets whether the request waits for the operation to complete,
+// or returns the ID of its task right away
+func (r *ReindexRequest) WithWaitForCompletion(wait bool) *ReindexRequest {
+	r.WaitForCompletion = &wait
+	return r
+}
+
+// NewReindexRemote instantiates a ReindexRemote for the cluster at host.
+func NewReindexRemote(host string) *ReindexRemote {
+	return &ReindexRemote{Host: host}
+}
+
+// WithBasicAuth sets the credentials used to authenticate with the remote cluster
+func (r *ReindexRemote) WithBasicAuth(username, password string) *ReindexRemote {
+	r.Username = username
+	r.Password = password
+	return r
+}
+
+// AddHeader sent with each request to the remote cluster
+func (r *ReindexRemote) AddHeader(name, value string) *ReindexRemote {
+	if r.Headers == nil {
+		r.Headers = map[string]string{name: value}
+	} else {
+		r.Headers[name] = value
+	}
+
+	return r
+}
+
+// WithTimeouts sets the socket and connect timeouts of the remote cluster
+func (r *ReindexRemote) WithTimeouts(socketTimeout, connectTimeout time.Duration) *ReindexRemote {
+	r.SocketTimeout = socketTimeout
+	r.ConnectTimeout = connectTimeout
+	return r
+}
@@ -0,0 +1,113 @@
+package opensearchtools
+
+import (
+	"context"
+	"time"
+)
+
+// Rollover defines a method which knows how to make an OpenSearch [Rollover] request.
+// It should be implemented by a version-specific executor.
+//
+// [Rollover]: https://opensearch.org/docs/latest/api-reference/index-apis/rollover/
+type Rollover interface {
+	Rollover(ctx context.Context, req *RolloverRequest) (OpenSearchResponse[RolloverResponse], error)
+}
+
+// RolloverRequest is a domain model union type for all the fields of a Rollover request for all
+// supported OpenSearch versions. The rollover target is either a data stream or an alias with a write index.
+// With no conditions, the target is rolled over unconditionally.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This RolloverRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	rolloverReq := NewRolloverRequest("logs").
+//		WithMaxAge(24 * time.Hour).
+//		WithMaxSize("50gb")
+//	rolloverResp, err := osv2Executor.Rollover(ctx, rolloverReq)
+type RolloverRequest struct {
+	// Target is the data stream or the alias to roll over
+	Target string
+
+	// NewIndex is the name of the new index of an alias. Generated from the name of the current index if empty.
+	NewIndex string
+
+	// MaxAge of the current index to roll over. Omitted if zero.
+	MaxAge time.Duration
+
+	// MaxDocs of the current index to roll over. Omitted if zero.
+	MaxDocs int64
+
+	// MaxSize of the current index to roll over, such as 50gb. Omitted if empty.
+	MaxSize string
+
+	// DryRun checks the conditions without rolling over
+	DryRun bool
+}
+
+// NewRolloverRequest instantiates a RolloverRequest for the given data stream or alias.
+func NewRolloverRequest(target string) *RolloverRequest {
+	return &RolloverRequest{
+		Target: target,
+	}
+}
+
+// WithNewIndex sets the name of the new index of the alias
+func (r *RolloverRequest) WithNewIndex(newIndex string) *RolloverRequest {
+	r.NewIndex = newIndex
+	return r
+}
+
+// WithMaxAge sets the maximum age of the current index
+func (r *RolloverRequest) WithMaxAge(maxAge time.Duration) *RolloverRequest {
+	r.MaxAge = maxAge
+	return r
+}
+
+// WithMaxDocs sets the maximum number of documents of the current index
+func (r *RolloverRequest) WithMaxDocs(maxDocs int64) *RolloverRequest {
+	r.MaxDocs = maxDocs
+	return r
+}
+
+// WithMaxSize sets the maximum size of the current index
+func (r *RolloverRequest) WithMaxSize(maxSize string) *RolloverRequest {
+	r.MaxSize = maxSize
+	return r
+}
+
+// WithDryRun sets whether the conditions are only checked
+func (r *RolloverRequest) WithDryRun(dryRun bool) *RolloverRequest {
+	r.DryRun = dryRun
+	return r
+}
+
+// RolloverResponse is a domain model union response type for RolloverRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type RolloverResponse struct {
+	// Acknowledged is true if the cluster created the new index
+	Acknowledged bool
+
+	// ShardsAcknowledged is true if the shards of the new index were started before the request timed out
+	ShardsAcknowledged bool
+
+	// OldIndex is the index written to before the rollover
+	OldIndex string
+
+	// NewIndex is the index written to after the rollover
+	NewIndex string
+
+	// RolledOver is true if the target was rolled over
+	RolledOver bool
+
+	// DryRun is true if the conditions were only checked
+	DryRun bool
+
+	// Conditions met, by condition, such as "[max_docs: 1000]"
+	Conditions map[string]bool
+
+	// Error of the request
+	Error *Error
+}
@@ -0,0 +1,75 @@
+package opensearchtools
+
+import (
+	"encoding/json"
+)
+
+// Script is an inline script that can be used by OpenSearch requests which modify or transform documents,
+// such as update requests. If no Lang is set, OpenSearch defaults to painless.
+// An empty Script will be rejected by OpenSearch as the source must be non-empty.
+//
+// For more details see https://opensearch.org/docs/latest/api-reference/script-apis/index/
+type Script struct {
+	// Source of the script to be executed
+	Source string
+
+	// Lang the script is written in
+	Lang string
+
+	// Params passed into the script, accessible by name through the params map
+	Params map[string]any
+}
+
+// NewScript instantiates a Script with the provided source.
+func NewScript(source string) *Script {
+	return &Script{Source: source}
+}
+
+// WithLang sets the script language
+func (s *Script) WithLang(lang string) *Script {
+	s.Lang = lang
+	return s
+}
+
+// AddParam to the script with the provided name
+func (s *Script) AddParam(name string, value any) *Script {
+	if s.Params == nil {
+		s.Params = map[string]any{name: value}
+	} else {
+		s.Params[name] = value
+	}
+
+	return s
+}
+
+// Validate that the script is executable.
+func (s *Script) Validate() ValidationResults {
+	vrs := NewValidationResults()
+
+	if s.Source == "" {
+		vrs.Add(NewValidationResult("a Script requires a source", true))
+	}
+
+	return vrs
+}
+
+// ToOpenSearchJSON converts the Script to the correct OpenSearch JSON.
+func (s *Script) ToOpenSearchJSON() ([]byte, error) {
+	if vrs := s.Validate(); vrs.IsFatal() {
+		return nil, NewValidationError(vrs)
+	}
+
+	source := map[string]any{
+		"source": s.Source,
+	}
+
+	if s.Lang != "" {
+		source["lang"] = s.Lang
+	}
+
+	if len(s.Params) > 0 {
+		source["params"] = s.Params
+	}
+
+	return json.Marshal(source)
+}
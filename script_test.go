@@ -0,0 +1,49 @@
+package opensearchtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScript_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  *Script
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Empty Script",
+			target:  &Script{},
+			wantErr: true,
+		},
+		{
+			name:    "Source only",
+			target:  NewScript("ctx._source.count++"),
+			want:    `{"source":"ctx._source.count++"}`,
+			wantErr: false,
+		},
+		{
+			name: "All fields",
+			target: NewScript("ctx._source.count += params.inc").
+				WithLang("painless").
+				AddParam("inc", 2),
+			want:    `{"source":"ctx._source.count += params.inc","lang":"painless","params":{"inc":2}}`,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.target.ToOpenSearchJSON()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToOpenSearchJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err == nil {
+				require.JSONEq(t, tt.want, string(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package opensearchtools
+
+import (
+	"context"
+	"time"
+)
+
+// Scroll defines a method which knows how to make an OpenSearch [Scroll] request to retrieve the next batch of
+// results of a scroll started by a [SearchRequest] with a Scroll keep alive.
+// It should be implemented by a version-specific executor.
+//
+// [Scroll]: https://opensearch.org/docs/latest/api-reference/scroll/
+type Scroll interface {
+	Scroll(ctx context.Context, req *ScrollRequest) (OpenSearchResponse[SearchResponse], error)
+}
+
+// ClearScroll defines a method which knows how to make an OpenSearch [Clear Scroll] request to release the
+// resources held by scrolls.
+// It should be implemented by a version-specific executor.
+//
+// [Clear Scroll]: https://opensearch.org/docs/latest/api-reference/scroll/
+type ClearScroll interface {
+	ClearScroll(ctx context.Context, req *ClearScrollRequest) (OpenSearchResponse[ClearScrollResponse], error)
+}
+
+// ScrollRequest is a domain model union type for all the fields of a Scroll request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This ScrollRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	searchResp, err := osv2Executor.Search(ctx, NewSearchRequest().WithScroll(time.Minute))
+//	scrollReq := NewScrollRequest(searchResp.Response.ScrollID, time.Minute)
+//	scrollResp, err := osv2Executor.Scroll(ctx, scrollReq)
+type ScrollRequest struct {
+	// ScrollID of the scroll, as returned by the previous [SearchResponse]
+	ScrollID string
+
+	// Scroll extends the life of the scroll until the next request
+	Scroll time.Duration
+}
+
+// NewScrollRequest instantiates a ScrollRequest for the scroll ID, keeping the scroll alive for keepAlive.
+func NewScrollRequest(scrollID string, keepAlive time.Duration) *ScrollRequest {
+	return &ScrollRequest{
+		ScrollID: scrollID,
+		Scroll:   keepAlive,
+	}
+}
+
+// ClearScrollRequest is a domain model union type for all the fields of a Clear Scroll request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// Either ScrollIDs or All must be set.
+type ClearScrollRequest struct {
+	// ScrollIDs to be cleared
+	ScrollIDs []string
+
+	// All clears every scroll of the cluster, ScrollIDs are ignored
+	All bool
+}
+
+// NewClearScrollRequest instantiates a ClearScrollRequest for the scroll IDs.
+func NewClearScrollRequest(scrollIDs ...string) *ClearScrollRequest {
+	return &ClearScrollRequest{
+		ScrollIDs: scrollIDs,
+	}
+}
+
+// ClearScrollResponse is a domain model union response type for ClearScrollRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type ClearScrollResponse struct {
+	// Succeeded is true if the scrolls were cleared
+	Succeeded bool
+
+	// NumFreed is the number of search contexts released
+	NumFreed int
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
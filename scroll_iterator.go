@@ -0,0 +1,145 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultScrollIteratorKeepAlive is the keep alive of the scroll of a [ScrollIterator] created without a positive keep alive
+const DefaultScrollIteratorKeepAlive = time.Minute
+
+// scrollClearTimeout bounds the clear scroll request made after the context of a [ScrollIterator] is done
+const scrollClearTimeout = 10 * time.Second
+
+// ScrollClient combines the methods required by a [ScrollIterator].
+// It is implemented by version-specific executors such as [opensearchtools/osv2.Executor].
+type ScrollClient interface {
+	Search
+	Scroll
+	ClearScroll
+}
+
+// ScrollIterator pages through all the hits of a [SearchRequest] using the scroll API,
+// for clusters where a [PointInTime] isn't available.
+// The request Size determines the size of each page.
+//
+//	iter := NewScrollIterator(osv2Executor, NewSearchRequest().AddIndices("example_index").WithSize(1000), time.Minute)
+//	defer iter.Close(ctx)
+//	for {
+//		page, err := iter.Next(ctx)
+//		if errors.Is(err, ErrIteratorDone) {
+//			break
+//		}
+//		...
+//	}
+//
+// The scroll is cleared once all hits have been returned, when Next fails, including when its context is done,
+// or when the iterator is closed. Close should always be called in case iteration stops early.
+// ScrollIterator is not safe for concurrent use.
+type ScrollIterator struct {
+	client    ScrollClient
+	req       SearchRequest
+	keepAlive time.Duration
+	scrollID  string
+	started   bool
+	done      bool
+}
+
+// NewScrollIterator instantiates a ScrollIterator for the request, keeping the scroll alive for keepAlive between pages.
+// A keepAlive which isn't positive is replaced by the [DefaultScrollIteratorKeepAlive], as a search without
+// a keep alive doesn't open a scroll.
+// The request is copied, so changes made to it after creating the iterator have no effect.
+func NewScrollIterator(client ScrollClient, req *SearchRequest, keepAlive time.Duration) *ScrollIterator {
+	if keepAlive <= 0 {
+		keepAlive = DefaultScrollIteratorKeepAlive
+	}
+
+	iterReq := *req
+	iterReq.Scroll = keepAlive
+
+	return &ScrollIterator{
+		client:    client,
+		req:       iterReq,
+		keepAlive: keepAlive,
+	}
+}
+
+// Next retrieves the next page of hits.
+// [ErrIteratorDone] is returned once there are no more hits.
+// If an error is returned the scroll is cleared, and every following call returns ErrIteratorDone.
+func (it *ScrollIterator) Next(ctx context.Context) (OpenSearchResponse[SearchResponse], error) {
+	if it.done {
+		return OpenSearchResponse[SearchResponse]{}, ErrIteratorDone
+	}
+
+	var (
+		resp OpenSearchResponse[SearchResponse]
+		err  error
+	)
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = ctxErr
+	} else if !it.started {
+		it.started = true
+		resp, err = it.client.Search(ctx, &it.req)
+	} else {
+		resp, err = it.client.Scroll(ctx, NewScrollRequest(it.scrollID, it.keepAlive))
+	}
+
+	if resp.Response.ScrollID != "" {
+		it.scrollID = resp.Response.ScrollID
+	}
+
+	if err == nil && resp.Response.Error != nil {
+		err = fmt.Errorf("scroll failed: %s: %s", resp.Response.Error.Type, resp.Response.Error.Reason)
+	}
+
+	if err != nil {
+		it.clear(ctx)
+		return resp, err
+	}
+
+	if len(resp.Response.Hits.Hits) == 0 {
+		it.clear(ctx)
+		return resp, ErrIteratorDone
+	}
+
+	return resp, nil
+}
+
+// Close clears the scroll if it hasn't been cleared yet.
+// An error is returned if the scroll could not be cleared.
+func (it *ScrollIterator) Close(ctx context.Context) error {
+	if it.done {
+		return nil
+	}
+
+	it.done = true
+	if it.scrollID == "" {
+		return nil
+	}
+
+	resp, err := it.client.ClearScroll(ctx, NewClearScrollRequest(it.scrollID))
+	if err != nil {
+		return err
+	}
+
+	if resp.Response.Error != nil {
+		return fmt.Errorf("clear scroll failed: %s: %s", resp.Response.Error.Type, resp.Response.Error.Reason)
+	}
+
+	return nil
+}
+
+// clear ends the iteration and clears the scroll, on a new context if ctx is done.
+// Failing to clear the scroll is not reported, as OpenSearch releases it once its keep alive expires.
+func (it *ScrollIterator) clear(ctx context.Context) {
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), scrollClearTimeout)
+		defer cancel()
+	}
+
+	_ = it.Close(ctx)
+}
@@ -0,0 +1,153 @@
+package opensearchtools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pagedScroll serves numDocs hits in pages of the search request Size, failing the scroll number failOnScroll if set.
+type pagedScroll struct {
+	numDocs      int
+	failOnScroll int
+	size         int
+	served       int
+	searchScroll time.Duration
+	scrolls      []ScrollRequest
+	cleared      []ClearScrollRequest
+	clearCtxErr  error
+}
+
+func (p *pagedScroll) page(scrollID string) OpenSearchResponse[SearchResponse] {
+	resp := SearchResponse{ScrollID: scrollID}
+	for ; p.served < p.numDocs && len(resp.Hits.Hits) < p.size; p.served++ {
+		resp.Hits.Hits = append(resp.Hits.Hits, Hit{Index: testIndex1, ID: fmt.Sprint(p.served)})
+	}
+
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, resp)
+}
+
+func (p *pagedScroll) Search(_ context.Context, req *SearchRequest) (OpenSearchResponse[SearchResponse], error) {
+	p.size = req.Size
+	p.searchScroll = req.Scroll
+	return p.page("scroll_0"), nil
+}
+
+func (p *pagedScroll) Scroll(_ context.Context, req *ScrollRequest) (OpenSearchResponse[SearchResponse], error) {
+	p.scrolls = append(p.scrolls, *req)
+	if len(p.scrolls) == p.failOnScroll {
+		return OpenSearchResponse[SearchResponse]{}, errors.New("scroll failed")
+	}
+
+	return p.page(fmt.Sprintf("scroll_%d", len(p.scrolls))), nil
+}
+
+func (p *pagedScroll) ClearScroll(ctx context.Context, req *ClearScrollRequest) (OpenSearchResponse[ClearScrollResponse], error) {
+	p.cleared = append(p.cleared, *req)
+	p.clearCtxErr = ctx.Err()
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, ClearScrollResponse{Succeeded: true, NumFreed: 1}), nil
+}
+
+func TestScrollIterator_DefaultKeepAlive(t *testing.T) {
+	client := &pagedScroll{numDocs: 3}
+	iter := NewScrollIterator(client, NewSearchRequest().WithSize(2), 0)
+
+	_, err := iter.Next(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, DefaultScrollIteratorKeepAlive, client.searchScroll)
+
+	_, err = iter.Next(context.Background())
+	require.Nil(t, err)
+	require.Len(t, client.scrolls, 1)
+	require.Equal(t, "scroll_0", client.scrolls[0].ScrollID)
+	require.Equal(t, DefaultScrollIteratorKeepAlive, client.scrolls[0].Scroll)
+}
+
+func TestScrollIterator_Next(t *testing.T) {
+	client := &pagedScroll{numDocs: 5}
+	iter := NewScrollIterator(client, NewSearchRequest().WithSize(2), time.Minute)
+
+	var ids []string
+	for {
+		page, err := iter.Next(context.Background())
+		if errors.Is(err, ErrIteratorDone) {
+			break
+		}
+
+		require.Nil(t, err)
+		for _, hit := range page.Response.Hits.Hits {
+			ids = append(ids, hit.ID)
+		}
+	}
+
+	require.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+	require.Equal(t, []ScrollRequest{
+		{ScrollID: "scroll_0", Scroll: time.Minute},
+		{ScrollID: "scroll_1", Scroll: time.Minute},
+		{ScrollID: "scroll_2", Scroll: time.Minute},
+	}, client.scrolls)
+	require.Equal(t, []ClearScrollRequest{{ScrollIDs: []string{"scroll_3"}}}, client.cleared)
+
+	// the scroll is only cleared once
+	require.Nil(t, iter.Close(context.Background()))
+	_, err := iter.Next(context.Background())
+	require.True(t, errors.Is(err, ErrIteratorDone))
+	require.Len(t, client.cleared, 1)
+}
+
+func TestScrollIterator_Error(t *testing.T) {
+	client := &pagedScroll{numDocs: 10, failOnScroll: 1}
+	iter := NewScrollIterator(client, NewSearchRequest().WithSize(2), time.Minute)
+
+	_, err := iter.Next(context.Background())
+	require.Nil(t, err)
+
+	_, err = iter.Next(context.Background())
+	require.NotNil(t, err)
+	require.False(t, errors.Is(err, ErrIteratorDone))
+	require.Equal(t, []ClearScrollRequest{{ScrollIDs: []string{"scroll_0"}}}, client.cleared)
+
+	_, err = iter.Next(context.Background())
+	require.True(t, errors.Is(err, ErrIteratorDone))
+}
+
+func TestScrollIterator_ContextCanceled(t *testing.T) {
+	client := &pagedScroll{numDocs: 10}
+	iter := NewScrollIterator(client, NewSearchRequest().WithSize(2), time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := iter.Next(ctx)
+	require.Nil(t, err)
+
+	cancel()
+	_, err = iter.Next(ctx)
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Empty(t, client.scrolls)
+	require.Equal(t, []ClearScrollRequest{{ScrollIDs: []string{"scroll_0"}}}, client.cleared)
+	require.Nil(t, client.clearCtxErr)
+}
+
+func TestScrollIterator_Close(t *testing.T) {
+	client := &pagedScroll{numDocs: 10}
+	iter := NewScrollIterator(client, NewSearchRequest().WithSize(2), time.Minute)
+
+	// nothing to clear before the first page
+	require.Nil(t, iter.Close(context.Background()))
+	require.Empty(t, client.cleared)
+
+	client = &pagedScroll{numDocs: 10}
+	iter = NewScrollIterator(client, NewSearchRequest().WithSize(2), time.Minute)
+	_, err := iter.Next(context.Background())
+	require.Nil(t, err)
+
+	require.Nil(t, iter.Close(context.Background()))
+	require.Equal(t, []ClearScrollRequest{{ScrollIDs: []string{"scroll_0"}}}, client.cleared)
+
+	_, err = iter.Next(context.Background())
+	require.True(t, errors.Is(err, ErrIteratorDone))
+}
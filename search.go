@@ -3,6 +3,7 @@ package opensearchtools
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"golang.org/x/exp/maps"
 )
@@ -54,6 +55,43 @@ type SearchRequest struct {
 
 	// Aggregations to be performed on the results of the Query
 	Aggregations map[string]Aggregation
+
+	// SearchAfter are the sort values of the last hit of the previous page, used to retrieve the next page
+	SearchAfter []any
+
+	// PointInTime searches a consistent snapshot of the indices the PIT was created for.
+	// When set, Index is ignored.
+	PointInTime *PointInTime
+
+	// Scroll starts a scroll kept alive for the duration, to retrieve the following results with a [ScrollRequest].
+	// Omitted if zero.
+	Scroll time.Duration
+
+	// Slice restricts a scroll or point-in-time search to one slice of its results,
+	// so that the slices can be consumed in parallel
+	Slice *Slice
+}
+
+// Slice identifies one of the Max slices a scroll or point-in-time search is split into.
+type Slice struct {
+	// ID of the slice, from 0 to Max - 1
+	ID int
+
+	// Max is the number of slices
+	Max int
+
+	// Field used to split the documents into slices, _id if empty.
+	// Splitting on a numeric doc values field is more efficient for large indices.
+	Field string
+}
+
+// PointInTime identifies a point-in-time (PIT) to be searched, and how long it should be kept alive for the next search.
+type PointInTime struct {
+	// ID of the PIT, as returned by a [CreatePITRequest] or the PITID of the previous [SearchResponse]
+	ID string
+
+	// KeepAlive extends the life of the PIT. Omitted if zero.
+	KeepAlive time.Duration
 }
 
 // NewSearchRequest instantiates a SearchRequest with a From and Size of -1.
@@ -112,6 +150,42 @@ func (r *SearchRequest) WithRouting(routing ...string) *SearchRequest {
 	return r
 }
 
+// WithSearchAfter sets the sort values of the last hit of the previous page to retrieve the next page of results.
+// The request must be sorted, and From must not be set.
+func (r *SearchRequest) WithSearchAfter(values ...any) *SearchRequest {
+	r.SearchAfter = values
+	return r
+}
+
+// WithPointInTime searches the point-in-time with the given id, extending its life by keepAlive.
+// The index of the request is ignored, as a PIT is bound to the indices it was created for.
+func (r *SearchRequest) WithPointInTime(id string, keepAlive time.Duration) *SearchRequest {
+	r.PointInTime = &PointInTime{
+		ID:        id,
+		KeepAlive: keepAlive,
+	}
+
+	return r
+}
+
+// WithScroll starts a scroll kept alive for keepAlive, whose ID is returned in the [SearchResponse].
+func (r *SearchRequest) WithScroll(keepAlive time.Duration) *SearchRequest {
+	r.Scroll = keepAlive
+	return r
+}
+
+// WithSlice restricts a scroll or point-in-time search to the slice with the given id of maxSlices slices,
+// split on the given field, or on _id if field is empty.
+func (r *SearchRequest) WithSlice(id, maxSlices int, field string) *SearchRequest {
+	r.Slice = &Slice{
+		ID:    id,
+		Max:   maxSlices,
+		Field: field,
+	}
+
+	return r
+}
+
 // AddAggregation to the search request with the desired name
 func (r *SearchRequest) AddAggregation(name string, agg Aggregation) *SearchRequest {
 	if r.Aggregations == nil {
@@ -145,6 +219,12 @@ type SearchResponse struct {
 
 	// Aggregations response if any were requested
 	Aggregations map[string]json.RawMessage
+
+	// PITID is the refreshed ID of the point-in-time if one was searched, to be used by the next search
+	PITID string
+
+	// ScrollID identifies the scroll if one was started, to be used by the next [ScrollRequest]
+	ScrollID string
 }
 
 // GetAggregationResultSource implements [opensearchtools.AggregationResultSet] to fetch an aggregation result and
@@ -202,6 +282,10 @@ type Hit struct {
 	ID     string
 	Score  float64
 	Source json.RawMessage
+
+	// Sort values of the hit, only returned when the request is sorted.
+	// Numeric values are decoded as [json.Number] to keep the precision of long values.
+	Sort []any
 }
 
 // GetSource returns the raw bytes of the document of the SearchRequest.
@@ -0,0 +1,139 @@
+package opensearchtools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrIteratorDone is returned by an iterator's Next method once all results have been returned.
+var ErrIteratorDone = errors.New("no more results in iterator")
+
+// DefaultSearchIteratorTiebreaker is the [Sort] appended to the sorts of a [SearchIterator] request so that
+// every hit has a unique position in the sort order.
+// Sorting on _id loads its fielddata into memory, which is deprecated in OpenSearch 2 and fails when disabled
+// with the indices.id_field_data.enabled cluster setting. Prefer a tiebreaker on a field with doc values and
+// a unique value per document, set with [SearchIterator.WithTiebreaker].
+var DefaultSearchIteratorTiebreaker = NewSort("_id", false)
+
+// SearchIterator pages through all the hits of a [SearchRequest] using search_after, avoiding the
+// from + size limit of a search's result window.
+// Each page is retrieved by a [Search] client, such as [opensearchtools/osv2.Executor], searching after the
+// sort values of the last hit of the previous page. The request Size determines the size of each page.
+//
+//	iter := NewSearchIterator(osv2Executor, NewSearchRequest().
+//		AddIndices("example_index").
+//		WithSize(1000).
+//		AddSorts(NewSort("timestamp", false)))
+//	for {
+//		page, err := iter.Next(ctx)
+//		if errors.Is(err, ErrIteratorDone) {
+//			break
+//		}
+//		...
+//	}
+//
+// A tiebreaker sort is appended to the request's sorts to give each hit a unique sort position,
+// otherwise hits sharing the same sort values at a page boundary may be skipped.
+// If the request searches a [PointInTime], every page is retrieved from the same consistent snapshot,
+// and the PIT ID returned by each page is used for the next one.
+// SearchIterator is not safe for concurrent use.
+type SearchIterator struct {
+	client      Search
+	req         SearchRequest
+	tiebreaker  Sort
+	searchAfter []any
+	started     bool
+	done        bool
+}
+
+// NewSearchIterator instantiates a SearchIterator for the request with the [DefaultSearchIteratorTiebreaker].
+// The request is copied, so changes made to it after creating the iterator have no effect.
+// The From and SearchAfter of the request are ignored, as the iterator always starts from the first hit.
+func NewSearchIterator(client Search, req *SearchRequest) *SearchIterator {
+	iterReq := *req
+	iterReq.From = -1
+	iterReq.SearchAfter = nil
+
+	return &SearchIterator{
+		client:     client,
+		req:        iterReq,
+		tiebreaker: DefaultSearchIteratorTiebreaker,
+	}
+}
+
+// WithTiebreaker replaces the tiebreaker sort, which should be a field with doc values and a unique value per document,
+// such as a keyword field holding a copy of the document ID. Using one avoids the _id fielddata required by
+// the [DefaultSearchIteratorTiebreaker]. It must be set before the first call to Next.
+func (it *SearchIterator) WithTiebreaker(tiebreaker Sort) *SearchIterator {
+	it.tiebreaker = tiebreaker
+	return it
+}
+
+// Next retrieves the next page of hits.
+// [ErrIteratorDone] is returned once there are no more hits.
+// An error is also returned if the search fails, or a hit is returned without sort values to search after.
+func (it *SearchIterator) Next(ctx context.Context) (OpenSearchResponse[SearchResponse], error) {
+	if it.done {
+		return OpenSearchResponse[SearchResponse]{}, ErrIteratorDone
+	}
+
+	if !it.started {
+		it.req.Sort = withTiebreaker(it.req.Sort, it.tiebreaker)
+		it.started = true
+	}
+
+	pageReq := it.req
+	pageReq.SearchAfter = it.searchAfter
+
+	resp, err := it.client.Search(ctx, &pageReq)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.Response.Error != nil {
+		return resp, fmt.Errorf("search failed: %s: %s", resp.Response.Error.Type, resp.Response.Error.Reason)
+	}
+
+	hits := resp.Response.Hits.Hits
+	if len(hits) == 0 {
+		it.done = true
+		return resp, ErrIteratorDone
+	}
+
+	// a partial page is the last page
+	if it.req.Size >= 0 && len(hits) < it.req.Size {
+		it.done = true
+	}
+
+	lastHit := hits[len(hits)-1]
+	if len(lastHit.Sort) == 0 {
+		it.done = true
+		return resp, fmt.Errorf("hit %s in index %s has no sort values to search after", lastHit.ID, lastHit.Index)
+	}
+
+	it.searchAfter = lastHit.Sort
+
+	// searches of a point-in-time must use the latest PIT ID
+	if it.req.PointInTime != nil && resp.Response.PITID != "" {
+		pit := *it.req.PointInTime
+		pit.ID = resp.Response.PITID
+		it.req.PointInTime = &pit
+	}
+
+	return resp, nil
+}
+
+// withTiebreaker returns a copy of sorts with the tiebreaker appended, unless the field is already sorted on
+func withTiebreaker(sorts []Sort, tiebreaker Sort) []Sort {
+	tiebroken := make([]Sort, 0, len(sorts)+1)
+	tiebroken = append(tiebroken, sorts...)
+
+	for _, s := range sorts {
+		if s.Field == tiebreaker.Field {
+			return tiebroken
+		}
+	}
+
+	return append(tiebroken, tiebreaker)
+}
@@ -0,0 +1,159 @@
+package opensearchtools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pagedSearch serves numDocs hits sorted by their position, honoring the request Size and SearchAfter.
+type pagedSearch struct {
+	numDocs  int
+	noSort   bool
+	requests []SearchRequest
+}
+
+func (p *pagedSearch) Search(_ context.Context, req *SearchRequest) (OpenSearchResponse[SearchResponse], error) {
+	p.requests = append(p.requests, *req)
+
+	start := 0
+	if len(req.SearchAfter) > 0 {
+		start = req.SearchAfter[0].(int) + 1
+	}
+
+	size := req.Size
+	if size < 0 {
+		size = 10
+	}
+
+	var resp SearchResponse
+	if req.PointInTime != nil {
+		resp.PITID = fmt.Sprintf("pit_%d", len(p.requests))
+	}
+
+	for i := start; i < p.numDocs && i < start+size; i++ {
+		hit := Hit{Index: testIndex1, ID: fmt.Sprint(i)}
+		if !p.noSort {
+			hit.Sort = []any{i}
+		}
+
+		resp.Hits.Hits = append(resp.Hits.Hits, hit)
+	}
+
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, resp), nil
+}
+
+func collectHits(t *testing.T, iter *SearchIterator) []string {
+	var ids []string
+	for {
+		page, err := iter.Next(context.Background())
+		if errors.Is(err, ErrIteratorDone) {
+			return ids
+		}
+
+		require.Nil(t, err)
+		for _, hit := range page.Response.Hits.Hits {
+			ids = append(ids, hit.ID)
+		}
+	}
+}
+
+func TestSearchIterator_Next(t *testing.T) {
+	tests := []struct {
+		name         string
+		numDocs      int
+		size         int
+		wantRequests int
+	}{
+		{
+			name:         "Partial last page",
+			numDocs:      25,
+			size:         10,
+			wantRequests: 3,
+		},
+		{
+			name:         "Full last page",
+			numDocs:      20,
+			size:         10,
+			wantRequests: 3,
+		},
+		{
+			name:         "No hits",
+			numDocs:      0,
+			size:         10,
+			wantRequests: 1,
+		},
+		{
+			name:         "Default size",
+			numDocs:      15,
+			size:         -1,
+			wantRequests: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &pagedSearch{numDocs: tt.numDocs}
+			req := NewSearchRequest().AddIndices(testIndex1).WithSize(tt.size).WithFrom(5)
+			iter := NewSearchIterator(client, req)
+
+			ids := collectHits(t, iter)
+			require.Len(t, ids, tt.numDocs)
+			for i, id := range ids {
+				require.Equal(t, fmt.Sprint(i), id)
+			}
+
+			require.Len(t, client.requests, tt.wantRequests)
+			require.Nil(t, client.requests[0].SearchAfter)
+			require.Equal(t, -1, client.requests[0].From)
+
+			// the iterator stays done
+			_, err := iter.Next(context.Background())
+			require.True(t, errors.Is(err, ErrIteratorDone))
+		})
+	}
+}
+
+func TestSearchIterator_Tiebreaker(t *testing.T) {
+	client := &pagedSearch{numDocs: 1}
+	req := NewSearchRequest().AddSorts(NewSort("timestamp", true))
+
+	collectHits(t, NewSearchIterator(client, req))
+	require.Equal(t, []Sort{NewSort("timestamp", true), DefaultSearchIteratorTiebreaker}, client.requests[0].Sort)
+	require.Equal(t, []Sort{NewSort("timestamp", true)}, req.Sort)
+
+	client = &pagedSearch{numDocs: 1}
+	collectHits(t, NewSearchIterator(client, req).WithTiebreaker(NewSort("timestamp", false)))
+	require.Equal(t, []Sort{NewSort("timestamp", true)}, client.requests[0].Sort)
+}
+
+func TestSearchIterator_MissingSortValues(t *testing.T) {
+	client := &pagedSearch{numDocs: 5, noSort: true}
+	iter := NewSearchIterator(client, NewSearchRequest().WithSize(2))
+
+	_, err := iter.Next(context.Background())
+	require.NotNil(t, err)
+	require.False(t, errors.Is(err, ErrIteratorDone))
+
+	_, err = iter.Next(context.Background())
+	require.True(t, errors.Is(err, ErrIteratorDone))
+}
+
+func TestSearchIterator_PointInTime(t *testing.T) {
+	client := &pagedSearch{numDocs: 5}
+	req := NewSearchRequest().WithSize(2).WithPointInTime("pit_0", time.Minute)
+
+	require.Len(t, collectHits(t, NewSearchIterator(client, req)), 5)
+	require.Len(t, client.requests, 3)
+	for i, searched := range client.requests {
+		require.Equal(t, fmt.Sprintf("pit_%d", i), searched.PointInTime.ID)
+		require.Equal(t, time.Minute, searched.PointInTime.KeepAlive)
+	}
+
+	require.Equal(t, "pit_0", req.PointInTime.ID)
+}
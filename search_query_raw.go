@@ -0,0 +1,31 @@
+package opensearchtools
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RawQuery is a query already in its OpenSearch JSON form, such as the filter of an alias
+// returned by OpenSearch, or a query type which isn't modeled by this package.
+//
+// For more details see https://opensearch.org/docs/latest/opensearch/query-dsl/index/
+type RawQuery struct {
+	source json.RawMessage
+}
+
+// NewRawQuery instantiates a RawQuery from the OpenSearch JSON of the query.
+func NewRawQuery(source []byte) *RawQuery {
+	return &RawQuery{
+		source: source,
+	}
+}
+
+// ToOpenSearchJSON returns the JSON of the RawQuery, failing if it isn't a valid JSON object.
+func (q *RawQuery) ToOpenSearchJSON() ([]byte, error) {
+	var query map[string]json.RawMessage
+	if err := json.Unmarshal(q.source, &query); err != nil {
+		return nil, fmt.Errorf("invalid raw query: %w", err)
+	}
+
+	return q.source, nil
+}
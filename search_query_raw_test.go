@@ -0,0 +1,47 @@
+package opensearchtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawQuery_ToOpenSearchJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   *RawQuery
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Query object",
+			query:   NewRawQuery([]byte(`{"term": {"field": "value"}}`)),
+			want:    `{"term": {"field": "value"}}`,
+			wantErr: false,
+		},
+		{
+			name:    "Invalid JSON",
+			query:   NewRawQuery([]byte(`{"term"`)),
+			wantErr: true,
+		},
+		{
+			name:    "Not an object",
+			query:   NewRawQuery([]byte(`["term"]`)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.ToOpenSearchJSON()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToOpenSearchJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				require.JSONEq(t, tt.want, string(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package opensearchtools
+
+import (
+	"context"
+	"time"
+)
+
+// GetSettings defines a method which knows how to make an OpenSearch [Get Settings] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get Settings]: https://opensearch.org/docs/latest/api-reference/index-apis/get-settings/
+type GetSettings interface {
+	GetSettings(ctx context.Context, req *GetSettingsRequest) (OpenSearchResponse[GetSettingsResponse], error)
+}
+
+// UpdateSettings defines a method which knows how to make an OpenSearch [Update Settings] request.
+// It should be implemented by a version-specific executor.
+//
+// [Update Settings]: https://opensearch.org/docs/latest/api-reference/index-apis/update-settings/
+type UpdateSettings interface {
+	UpdateSettings(ctx context.Context, req *UpdateSettingsRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// RefreshIntervalDisabled is the refresh interval disabling the periodic refreshes of an index.
+const RefreshIntervalDisabled time.Duration = -1
+
+// IndexSetting is the name of a dynamic index setting which can be reset to its default value.
+type IndexSetting string
+
+const (
+	// IndexSettingNumberOfReplicas is the number_of_replicas setting
+	IndexSettingNumberOfReplicas IndexSetting = "number_of_replicas"
+
+	// IndexSettingAutoExpandReplicas is the auto_expand_replicas setting
+	IndexSettingAutoExpandReplicas IndexSetting = "auto_expand_replicas"
+
+	// IndexSettingRefreshInterval is the refresh_interval setting
+	IndexSettingRefreshInterval IndexSetting = "refresh_interval"
+
+	// IndexSettingMaxResultWindow is the max_result_window setting
+	IndexSettingMaxResultWindow IndexSetting = "max_result_window"
+)
+
+// IndexSettings is a domain model union type for the settings of an index for all supported OpenSearch versions.
+// Unset fields are omitted, keeping the current value of the setting.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// For example:
+//
+//	settings := NewIndexSettings().
+//		WithNumberOfShards(3).
+//		WithNumberOfReplicas(1).
+//		WithRefreshInterval(30 * time.Second)
+//
+// For more details see https://opensearch.org/docs/latest/install-and-configure/configuring-opensearch/index-settings/
+type IndexSettings struct {
+	// NumberOfShards is the number of primary shards, it can only be set when the index is created. Omitted if zero.
+	NumberOfShards int
+
+	// NumberOfReplicas is the number of replicas of each primary shard. Omitted if nil.
+	NumberOfReplicas *int
+
+	// AutoExpandReplicas expands the number of replicas with the number of nodes, such as 0-all
+	AutoExpandReplicas string
+
+	// RefreshInterval between the refreshes making recent changes searchable, or [RefreshIntervalDisabled]. Omitted if nil.
+	RefreshInterval *time.Duration
+
+	// MaxResultWindow is the maximum from + size of searches. Omitted if zero.
+	MaxResultWindow int
+
+	// KNN enables the k-NN indexing of knn_vector fields, it can only be set when the index is created. Omitted if nil.
+	KNN *bool
+
+	// Analysis defines the custom analyzers and their components, the index must be closed to update it
+	Analysis *Analysis
+
+	// Reset lists the settings to reset to their default value, removing any explicit value.
+	// It is only used when updating the settings of an index.
+	Reset []IndexSetting
+}
+
+// NewIndexSettings instantiates empty IndexSettings.
+func NewIndexSettings() *IndexSettings {
+	return &IndexSettings{}
+}
+
+// WithNumberOfShards sets the number of primary shards
+func (s *IndexSettings) WithNumberOfShards(shards int) *IndexSettings {
+	s.NumberOfShards = shards
+	return s
+}
+
+// WithNumberOfReplicas sets the number of replicas of each primary shard
+func (s *IndexSettings) WithNumberOfReplicas(replicas int) *IndexSettings {
+	s.NumberOfReplicas = &replicas
+	return s
+}
+
+// WithAutoExpandReplicas sets the range the number of replicas expands in
+func (s *IndexSettings) WithAutoExpandReplicas(autoExpandReplicas string) *IndexSettings {
+	s.AutoExpandReplicas = autoExpandReplicas
+	return s
+}
+
+// WithRefreshInterval sets the refresh interval, [RefreshIntervalDisabled] disables the periodic refreshes
+func (s *IndexSettings) WithRefreshInterval(interval time.Duration) *IndexSettings {
+	s.RefreshInterval = &interval
+	return s
+}
+
+// WithMaxResultWindow sets the maximum from + size of searches
+func (s *IndexSettings) WithMaxResultWindow(maxResultWindow int) *IndexSettings {
+	s.MaxResultWindow = maxResultWindow
+	return s
+}
+
+// WithKNN sets whether knn_vector fields are indexed for k-NN search
+func (s *IndexSettings) WithKNN(knn bool) *IndexSettings {
+	s.KNN = &knn
+	return s
+}
+
+// WithAnalysis sets the custom analyzers and their components
+func (s *IndexSettings) WithAnalysis(analysis *Analysis) *IndexSettings {
+	s.Analysis = analysis
+	return s
+}
+
+// WithReset adds settings to reset to their default value when updating the settings of an index
+func (s *IndexSettings) WithReset(settings ...IndexSetting) *IndexSettings {
+	s.Reset = append(s.Reset, settings...)
+	return s
+}
+
+// Analysis is the analysis block of the [IndexSettings], defining custom analyzers and their components by name.
+// Each definition holds the type and parameters of the component as expected by OpenSearch. For example:
+//
+//	analysis := NewAnalysis().
+//		AddFilter("english_stop", map[string]any{"type": "stop", "stopwords": "_english_"}).
+//		AddAnalyzer("english_custom", map[string]any{
+//			"type":      "custom",
+//			"tokenizer": "standard",
+//			"filter":    []string{"lowercase", "english_stop"},
+//		})
+//
+// For more details see https://opensearch.org/docs/latest/analyzers/index/
+type Analysis struct {
+	Analyzers   map[string]map[string]any
+	Tokenizers  map[string]map[string]any
+	Filters     map[string]map[string]any
+	CharFilters map[string]map[string]any
+	Normalizers map[string]map[string]any
+}
+
+// NewAnalysis instantiates an empty Analysis.
+func NewAnalysis() *Analysis {
+	return &Analysis{}
+}
+
+// AddAnalyzer defines the analyzer with the given name
+func (a *Analysis) AddAnalyzer(name string, definition map[string]any) *Analysis {
+	a.Analyzers = addAnalysisComponent(a.Analyzers, name, definition)
+	return a
+}
+
+// AddTokenizer defines the tokenizer with the given name
+func (a *Analysis) AddTokenizer(name string, definition map[string]any) *Analysis {
+	a.Tokenizers = addAnalysisComponent(a.Tokenizers, name, definition)
+	return a
+}
+
+// AddFilter defines the token filter with the given name
+func (a *Analysis) AddFilter(name string, definition map[string]any) *Analysis {
+	a.Filters = addAnalysisComponent(a.Filters, name, definition)
+	return a
+}
+
+// AddCharFilter defines the character filter with the given name
+func (a *Analysis) AddCharFilter(name string, definition map[string]any) *Analysis {
+	a.CharFilters = addAnalysisComponent(a.CharFilters, name, definition)
+	return a
+}
+
+// AddNormalizer defines the normalizer with the given name
+func (a *Analysis) AddNormalizer(name string, definition map[string]any) *Analysis {
+	a.Normalizers = addAnalysisComponent(a.Normalizers, name, definition)
+	return a
+}
+
+// addAnalysisComponent adds the definition to components, allocating it if needed
+func addAnalysisComponent(components map[string]map[string]any, name string, definition map[string]any) map[string]map[string]any {
+	if components == nil {
+		components = make(map[string]map[string]any)
+	}
+
+	components[name] = definition
+	return components
+}
+
+// GetSettingsRequest is a domain model union type for all the fields of a Get Settings request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetSettingsRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetSettingsRequest("example_index").WithIncludeDefaults(true)
+//	getResp, err := osv2Executor.GetSettings(ctx, getReq)
+type GetSettingsRequest struct {
+	// Indices whose settings to get, wildcards are supported. All indices if empty.
+	Indices []string
+
+	// IncludeDefaults fills the settings which aren't set explicitly with their default value
+	IncludeDefaults bool
+}
+
+// NewGetSettingsRequest instantiates a GetSettingsRequest for the given indices.
+func NewGetSettingsRequest(indices ...string) *GetSettingsRequest {
+	return &GetSettingsRequest{
+		Indices: indices,
+	}
+}
+
+// WithIncludeDefaults sets whether the default values of the settings are included
+func (r *GetSettingsRequest) WithIncludeDefaults(includeDefaults bool) *GetSettingsRequest {
+	r.IncludeDefaults = includeDefaults
+	return r
+}
+
+// GetSettingsResponse is a domain model union response type for GetSettingsRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetSettingsResponse struct {
+	// Settings of the indices, by index name
+	Settings map[string]*IndexSettings
+
+	// Error of the request, such as when an index doesn't exist
+	Error *Error
+}
+
+// UpdateSettingsRequest is a domain model union type for all the fields of an Update Settings request for all
+// supported OpenSearch versions.
+// Only dynamic settings can be updated on an open index.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This UpdateSettingsRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	updateReq := NewUpdateSettingsRequest(NewIndexSettings().WithNumberOfReplicas(2), "example_index")
+//	updateResp, err := osv2Executor.UpdateSettings(ctx, updateReq)
+type UpdateSettingsRequest struct {
+	// Indices whose settings to update, wildcards are supported
+	Indices []string
+
+	// Settings to apply
+	Settings *IndexSettings
+}
+
+// NewUpdateSettingsRequest instantiates an UpdateSettingsRequest applying the settings to the given indices.
+func NewUpdateSettingsRequest(settings *IndexSettings, indices ...string) *UpdateSettingsRequest {
+	return &UpdateSettingsRequest{
+		Indices:  indices,
+		Settings: settings,
+	}
+}
@@ -0,0 +1,167 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// settingsRestoreTimeout bounds the request restoring the original settings once the function
+// given to [WithIndexSettings] returns, whose context may be done
+const settingsRestoreTimeout = 30 * time.Second
+
+// IndexSettingsClient combines the methods required by [WithIndexSettings].
+// It is implemented by version-specific executors such as [opensearchtools/osv2.Executor].
+type IndexSettingsClient interface {
+	GetSettings
+	UpdateSettings
+}
+
+// BulkLoadSettings returns the settings speeding up large loads into an index:
+// periodic refreshes are disabled and the index has no replicas.
+func BulkLoadSettings() *IndexSettings {
+	return NewIndexSettings().
+		WithRefreshInterval(RefreshIntervalDisabled).
+		WithNumberOfReplicas(0)
+}
+
+// WithBulkLoadSettings calls fn with the [BulkLoadSettings] applied to the index,
+// restoring its original settings when fn returns. See [WithIndexSettings].
+//
+//	err := WithBulkLoadSettings(ctx, osv2Executor, "example_index", func(ctx context.Context) error {
+//		return loadDocuments(ctx, bulkIndexer)
+//	})
+func WithBulkLoadSettings(ctx context.Context, client IndexSettingsClient, index string, fn func(ctx context.Context) error) error {
+	return WithIndexSettings(ctx, client, index, BulkLoadSettings(), fn)
+}
+
+// WithIndexSettings calls fn with the settings applied to the index, restoring the original value
+// of each applied setting when fn returns, including when it fails or panics.
+// Settings which weren't set explicitly on the index are reset to their default value rather than
+// set to the value they defaulted to.
+// Only the dynamic settings NumberOfReplicas, AutoExpandReplicas, RefreshInterval and MaxResultWindow are supported.
+// The index must resolve to a single index, such as an alias with a single index.
+//
+// The original settings are restored with their own timeout, even if ctx is done.
+// An error is returned if
+//
+//   - The settings are nil or include settings which aren't supported
+//   - Getting the original settings, or applying the settings fails
+//   - fn returns an error
+//   - Restoring the original settings fails
+func WithIndexSettings(ctx context.Context, client IndexSettingsClient, index string, settings *IndexSettings, fn func(ctx context.Context) error) (err error) {
+	if settings == nil {
+		return fmt.Errorf("no settings to apply temporarily to index %s", index)
+	}
+
+	if settings.NumberOfShards != 0 || settings.KNN != nil || settings.Analysis != nil || len(settings.Reset) > 0 {
+		return fmt.Errorf("only dynamic settings can be applied temporarily to index %s", index)
+	}
+
+	current, err := indexSettings(ctx, client, index)
+	if err != nil {
+		return err
+	}
+
+	originals := originalSettings(current, settings)
+
+	if err := applySettings(ctx, client, index, settings); err != nil {
+		return err
+	}
+
+	defer func() {
+		restoreCtx, cancel := context.WithTimeout(context.Background(), settingsRestoreTimeout)
+		defer cancel()
+
+		restoreErr := applySettings(restoreCtx, client, index, originals)
+		switch {
+		case restoreErr == nil:
+		case err == nil:
+			err = fmt.Errorf("restoring original settings: %w", restoreErr)
+		default:
+			err = fmt.Errorf("%w, restoring original settings also failed: %v", err, restoreErr)
+		}
+	}()
+
+	return fn(ctx)
+}
+
+// indexSettings gets the explicit settings of the single index resolved by index, without the default values
+func indexSettings(ctx context.Context, client GetSettings, index string) (*IndexSettings, error) {
+	resp, err := client.GetSettings(ctx, NewGetSettingsRequest(index))
+	if err != nil {
+		return nil, fmt.Errorf("getting settings of index %s: %w", index, err)
+	}
+
+	if resp.Response.Error != nil {
+		return nil, fmt.Errorf("getting settings of index %s failed: %s: %s", index, resp.Response.Error.Type, resp.Response.Error.Reason)
+	}
+
+	if len(resp.Response.Settings) != 1 {
+		return nil, fmt.Errorf("index %s resolves to %d indices instead of 1", index, len(resp.Response.Settings))
+	}
+
+	for _, settings := range resp.Response.Settings {
+		return settings, nil
+	}
+
+	return nil, nil
+}
+
+// originalSettings picks the explicit value of each of the applied settings,
+// resetting the applied settings which weren't set explicitly
+func originalSettings(current, applied *IndexSettings) *IndexSettings {
+	originals := NewIndexSettings()
+
+	if applied.NumberOfReplicas != nil {
+		if current.NumberOfReplicas != nil {
+			originals.NumberOfReplicas = current.NumberOfReplicas
+		} else {
+			originals.WithReset(IndexSettingNumberOfReplicas)
+		}
+	}
+
+	if applied.AutoExpandReplicas != "" {
+		if current.AutoExpandReplicas != "" {
+			originals.AutoExpandReplicas = current.AutoExpandReplicas
+		} else {
+			originals.WithReset(IndexSettingAutoExpandReplicas)
+		}
+	}
+
+	if applied.RefreshInterval != nil {
+		if current.RefreshInterval != nil {
+			originals.RefreshInterval = current.RefreshInterval
+		} else {
+			originals.WithReset(IndexSettingRefreshInterval)
+		}
+	}
+
+	if applied.MaxResultWindow != 0 {
+		if current.MaxResultWindow != 0 {
+			originals.MaxResultWindow = current.MaxResultWindow
+		} else {
+			originals.WithReset(IndexSettingMaxResultWindow)
+		}
+	}
+
+	return originals
+}
+
+// applySettings updates the settings of the index, failing if the update isn't acknowledged
+func applySettings(ctx context.Context, client UpdateSettings, index string, settings *IndexSettings) error {
+	resp, err := client.UpdateSettings(ctx, NewUpdateSettingsRequest(settings, index))
+	if err != nil {
+		return fmt.Errorf("updating settings of index %s: %w", index, err)
+	}
+
+	if resp.Response.Error != nil {
+		return fmt.Errorf("updating settings of index %s failed: %s: %s", index, resp.Response.Error.Type, resp.Response.Error.Reason)
+	}
+
+	if !resp.Response.Acknowledged {
+		return fmt.Errorf("updating settings of index %s wasn't acknowledged", index)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package opensearchtools
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// settingsIndex holds the explicit settings of a single index, recording every update.
+// The refresh interval defaults to a second.
+type settingsIndex struct {
+	settings  *IndexSettings
+	updates   []*IndexSettings
+	updateErr *Error
+}
+
+func (s *settingsIndex) GetSettings(_ context.Context, req *GetSettingsRequest) (OpenSearchResponse[GetSettingsResponse], error) {
+	settings := *s.settings
+	if req.IncludeDefaults && settings.RefreshInterval == nil {
+		settings.WithRefreshInterval(time.Second)
+	}
+
+	resp := GetSettingsResponse{
+		Settings: map[string]*IndexSettings{req.Indices[0]: &settings},
+	}
+
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, resp), nil
+}
+
+func (s *settingsIndex) UpdateSettings(_ context.Context, req *UpdateSettingsRequest) (OpenSearchResponse[AcknowledgedResponse], error) {
+	s.updates = append(s.updates, req.Settings)
+	if s.updateErr != nil {
+		return NewOpenSearchResponse(NewValidationResults(), http.StatusBadRequest, nil, AcknowledgedResponse{Error: s.updateErr}), nil
+	}
+
+	if req.Settings.NumberOfReplicas != nil {
+		s.settings.NumberOfReplicas = req.Settings.NumberOfReplicas
+	}
+
+	if req.Settings.RefreshInterval != nil {
+		s.settings.RefreshInterval = req.Settings.RefreshInterval
+	}
+
+	for _, reset := range req.Settings.Reset {
+		if reset == IndexSettingRefreshInterval {
+			s.settings.RefreshInterval = nil
+		}
+	}
+
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, AcknowledgedResponse{Acknowledged: true}), nil
+}
+
+// newSettingsIndex creates a settingsIndex with 2 replicas refreshed every second.
+func newSettingsIndex() *settingsIndex {
+	return &settingsIndex{
+		settings: NewIndexSettings().
+			WithNumberOfShards(1).
+			WithNumberOfReplicas(2).
+			WithRefreshInterval(time.Second).
+			WithMaxResultWindow(10000),
+	}
+}
+
+func TestWithBulkLoadSettings(t *testing.T) {
+	index := newSettingsIndex()
+
+	err := WithBulkLoadSettings(context.Background(), index, "test_index", func(ctx context.Context) error {
+		require.Equal(t, 0, *index.settings.NumberOfReplicas)
+		require.Equal(t, RefreshIntervalDisabled, *index.settings.RefreshInterval)
+		return nil
+	})
+	require.Nil(t, err)
+
+	require.Equal(t, 2, *index.settings.NumberOfReplicas)
+	require.Equal(t, time.Second, *index.settings.RefreshInterval)
+	require.Equal(t, []*IndexSettings{
+		BulkLoadSettings(),
+		NewIndexSettings().WithNumberOfReplicas(2).WithRefreshInterval(time.Second),
+	}, index.updates)
+}
+
+func TestWithBulkLoadSettings_DefaultedSettings(t *testing.T) {
+	index := &settingsIndex{settings: NewIndexSettings().WithNumberOfShards(1).WithNumberOfReplicas(2)}
+
+	err := WithBulkLoadSettings(context.Background(), index, "test_index", func(ctx context.Context) error {
+		require.Equal(t, RefreshIntervalDisabled, *index.settings.RefreshInterval)
+		return nil
+	})
+	require.Nil(t, err)
+
+	// the refresh interval was never set, so it is reset to its default rather than set to a second
+	require.Nil(t, index.settings.RefreshInterval)
+	require.Equal(t, NewIndexSettings().WithNumberOfReplicas(2).WithReset(IndexSettingRefreshInterval), index.updates[1])
+}
+
+func TestWithIndexSettings_NilSettings(t *testing.T) {
+	index := newSettingsIndex()
+
+	err := WithIndexSettings(context.Background(), index, "test_index", nil, func(ctx context.Context) error {
+		return nil
+	})
+	require.NotNil(t, err)
+	require.Empty(t, index.updates)
+}
+
+func TestWithIndexSettings_FnFails(t *testing.T) {
+	index := newSettingsIndex()
+	fnErr := errors.New("load failed")
+
+	err := WithIndexSettings(context.Background(), index, "test_index", NewIndexSettings().WithNumberOfReplicas(0), func(ctx context.Context) error {
+		return fnErr
+	})
+	require.True(t, errors.Is(err, fnErr))
+	require.Equal(t, 2, *index.settings.NumberOfReplicas)
+	require.Len(t, index.updates, 2)
+}
+
+func TestWithIndexSettings_Panics(t *testing.T) {
+	index := newSettingsIndex()
+
+	require.Panics(t, func() {
+		_ = WithBulkLoadSettings(context.Background(), index, "test_index", func(ctx context.Context) error {
+			panic("load panicked")
+		})
+	})
+	require.Equal(t, 2, *index.settings.NumberOfReplicas)
+	require.Equal(t, time.Second, *index.settings.RefreshInterval)
+}
+
+func TestWithIndexSettings_ApplyFails(t *testing.T) {
+	index := newSettingsIndex()
+	index.updateErr = &Error{Type: "illegal_argument_exception", Reason: "invalid setting"}
+
+	called := false
+	err := WithBulkLoadSettings(context.Background(), index, "test_index", func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	require.NotNil(t, err)
+	require.False(t, called)
+	require.Len(t, index.updates, 1)
+}
+
+func TestWithIndexSettings_Unsupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *IndexSettings
+	}{
+		{name: "Number of shards", settings: NewIndexSettings().WithNumberOfShards(2)},
+		{name: "KNN", settings: NewIndexSettings().WithKNN(true)},
+		{name: "Analysis", settings: NewIndexSettings().WithAnalysis(NewAnalysis())},
+		{name: "Reset", settings: NewIndexSettings().WithReset(IndexSettingRefreshInterval)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := newSettingsIndex()
+
+			err := WithIndexSettings(context.Background(), index, "test_index", tt.settings, func(ctx context.Context) error {
+				return nil
+			})
+			require.NotNil(t, err)
+			require.Empty(t, index.updates)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package opensearchtools
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// GetTask defines a method which knows how to make an OpenSearch [Get task] request, to follow the
+// progress of an operation started without waiting for its completion.
+// It should be implemented by a version-specific executor.
+//
+// [Get task]: https://opensearch.org/docs/latest/api-reference/tasks/
+type GetTask interface {
+	GetTask(ctx context.Context, req *GetTaskRequest) (OpenSearchResponse[GetTaskResponse], error)
+}
+
+// ListTasks defines a method which knows how to make an OpenSearch [List tasks] request.
+// It should be implemented by a version-specific executor.
+//
+// [List tasks]: https://opensearch.org/docs/latest/api-reference/tasks/
+type ListTasks interface {
+	ListTasks(ctx context.Context, req *ListTasksRequest) (OpenSearchResponse[ListTasksResponse], error)
+}
+
+// CancelTask defines a method which knows how to make an OpenSearch [Cancel task] request.
+// It should be implemented by a version-specific executor.
+//
+// [Cancel task]: https://opensearch.org/docs/latest/api-reference/tasks/
+type CancelTask interface {
+	CancelTask(ctx context.Context, req *CancelTaskRequest) (OpenSearchResponse[CancelTaskResponse], error)
+}
+
+// GetTaskRequest is a domain model union type for all the fields of a Get task request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetTaskRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteResp, err := osv2Executor.DeleteByQuery(ctx, deleteReq.WithWaitForCompletion(false))
+//	taskReq := NewGetTaskRequest(deleteResp.Response.Task).WithWaitForCompletion(time.Minute)
+//	taskResp, err := osv2Executor.GetTask(ctx, taskReq)
+type GetTaskRequest struct {
+	// TaskID of the task, formatted as node_id:task_number
+	TaskID string
+
+	// WaitForCompletion blocks the request until the task completes
+	WaitForCompletion bool
+
+	// Timeout of the wait for completion. Omitted if zero, OpenSearch defaults to 30 seconds.
+	Timeout time.Duration
+}
+
+// NewGetTaskRequest instantiates a GetTaskRequest for the task with the given ID.
+func NewGetTaskRequest(taskID string) *GetTaskRequest {
+	return &GetTaskRequest{
+		TaskID: taskID,
+	}
+}
+
+// WithWaitForCompletion blocks the request until the task completes, or the timeout elapses.
+// A timeout of zero uses the default timeout of OpenSearch.
+func (r *GetTaskRequest) WithWaitForCompletion(timeout time.Duration) *GetTaskRequest {
+	r.WaitForCompletion = true
+	r.Timeout = timeout
+	return r
+}
+
+// GetTaskResponse is a domain model union response type for GetTaskRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetTaskResponse struct {
+	// Completed is true once the task has finished, successfully or not
+	Completed bool
+
+	// Task describing the operation and its progress
+	Task TaskInfo
+
+	// Response of the operation once completed, in the shape of the operation's response
+	Response json.RawMessage
+
+	// BulkByScrollResponse is the Response decoded for bulk by scroll operations such as a
+	// [DeleteByQueryRequest] or an [UpdateByQueryRequest]
+	BulkByScrollResponse *BulkByScrollResponse
+
+	// TaskError is the error the operation failed with, once completed
+	TaskError *Error
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
+
+// TaskInfo describes a task running, or having run, on a node of the cluster.
+type TaskInfo struct {
+	// Node the task runs on
+	Node string
+
+	// ID of the task on its node
+	ID int64
+
+	// Type of the task
+	Type string
+
+	// Action performed by the task, such as indices:data/write/delete/byquery
+	Action string
+
+	// Description of the task
+	Description string
+
+	// StartTime of the task
+	StartTime time.Time
+
+	// RunningTime of the task
+	RunningTime time.Duration
+
+	// Cancellable is true if the task can be cancelled
+	Cancellable bool
+
+	// Cancelled is true if the task has been cancelled
+	Cancelled bool
+
+	// ParentTaskID is the node_id:task_number of the parent task, if any
+	ParentTaskID string
+
+	// Status of the task, in the shape of the operation's status
+	Status json.RawMessage
+
+	// Headers of the request which started the task
+	Headers map[string]string
+}
+
+// TaskID returns the ID of the task formatted as node_id:task_number.
+func (t TaskInfo) TaskID() string {
+	return t.Node + ":" + strconv.FormatInt(t.ID, 10)
+}
+
+// ListTasksRequest is a domain model union type for all the fields of a List tasks request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This ListTasksRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	listReq := NewListTasksRequest().
+//		WithActions("indices:data/write/reindex*").
+//		WithDetailed(true)
+//	listResp, err := osv2Executor.ListTasks(ctx, listReq)
+type ListTasksRequest struct {
+	// Actions filters the tasks by action, wildcards are supported
+	Actions []string
+
+	// Nodes filters the tasks by the node they run on, by node ID, name or address
+	Nodes []string
+
+	// ParentTaskID filters the tasks by parent task, formatted as node_id:task_number
+	ParentTaskID string
+
+	// Detailed includes the description and status of each task
+	Detailed bool
+}
+
+// NewListTasksRequest instantiates an empty ListTasksRequest, listing all the tasks of the cluster.
+func NewListTasksRequest() *ListTasksRequest {
+	return &ListTasksRequest{}
+}
+
+// WithActions filters the tasks by action, wildcards are supported
+func (r *ListTasksRequest) WithActions(actions ...string) *ListTasksRequest {
+	r.Actions = actions
+	return r
+}
+
+// WithNodes filters the tasks by the node they run on
+func (r *ListTasksRequest) WithNodes(nodes ...string) *ListTasksRequest {
+	r.Nodes = nodes
+	return r
+}
+
+// WithParentTaskID filters the tasks by parent task
+func (r *ListTasksRequest) WithParentTaskID(parentTaskID string) *ListTasksRequest {
+	r.ParentTaskID = parentTaskID
+	return r
+}
+
+// WithDetailed sets whether the description and status of each task are included
+func (r *ListTasksRequest) WithDetailed(detailed bool) *ListTasksRequest {
+	r.Detailed = detailed
+	return r
+}
+
+// ListTasksResponse is a domain model union response type for ListTasksRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type ListTasksResponse struct {
+	// Tasks matching the request
+	Tasks []TaskInfo
+
+	// NodeFailures of nodes which failed to list their tasks
+	NodeFailures []Error
+
+	// TaskFailures of tasks which failed to be listed
+	TaskFailures []TaskFailure
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
+
+// CancelTaskRequest is a domain model union type for all the fields of a Cancel task request for all
+// supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// Either a TaskID or filters must be set, cancelling every matching cancellable task.
+// This CancelTaskRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	cancelResp, err := osv2Executor.CancelTask(ctx, NewCancelTaskRequest("node_id:42"))
+type CancelTaskRequest struct {
+	// TaskID of the task to cancel, formatted as node_id:task_number
+	TaskID string
+
+	// Actions filters the tasks to cancel by action, wildcards are supported
+	Actions []string
+
+	// Nodes filters the tasks to cancel by the node they run on
+	Nodes []string
+
+	// WaitForCompletion blocks the request until the cancelled tasks complete
+	WaitForCompletion bool
+}
+
+// NewCancelTaskRequest instantiates a CancelTaskRequest for the task with the given ID.
+func NewCancelTaskRequest(taskID string) *CancelTaskRequest {
+	return &CancelTaskRequest{
+		TaskID: taskID,
+	}
+}
+
+// WithActions filters the tasks to cancel by action, wildcards are supported
+func (r *CancelTaskRequest) WithActions(actions ...string) *CancelTaskRequest {
+	r.Actions = actions
+	return r
+}
+
+// WithNodes filters the tasks to cancel by the node they run on
+func (r *CancelTaskRequest) WithNodes(nodes ...string) *CancelTaskRequest {
+	r.Nodes = nodes
+	return r
+}
+
+// WithWaitForCompletion sets whether the request waits for the cancelled tasks to complete
+func (r *CancelTaskRequest) WithWaitForCompletion(wait bool) *CancelTaskRequest {
+	r.WaitForCompletion = wait
+	return r
+}
+
+// CancelTaskResponse is a domain model union response type for CancelTaskRequest for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type CancelTaskResponse struct {
+	// Tasks which were cancelled
+	Tasks []TaskInfo
+
+	// NodeFailures of nodes which failed to cancel their tasks
+	NodeFailures []Error
+
+	// TaskFailures of tasks which failed to be cancelled
+	TaskFailures []TaskFailure
+
+	// Error if OpenSearch failed but responded with errors
+	Error *Error
+}
+
+// TaskFailure is a failure to act on a task.
+type TaskFailure struct {
+	// NodeID of the node the task runs on
+	NodeID string
+
+	// TaskID of the task on its node
+	TaskID int64
+
+	// Status of the failure
+	Status string
+
+	// Reason of the failure
+	Reason Error
+}
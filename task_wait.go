@@ -0,0 +1,66 @@
+package opensearchtools
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultTaskPollInterval is the poll interval used by [WaitForTask] when none is given.
+const DefaultTaskPollInterval = time.Second
+
+// TaskFailedError is returned by [WaitForTask] when the task completed with an error.
+type TaskFailedError struct {
+	// TaskID of the failed task
+	TaskID string
+
+	// Cause the task failed with
+	Cause Error
+}
+
+// Error returns the type and reason of the task's failure.
+func (e *TaskFailedError) Error() string {
+	return fmt.Sprintf("task %s failed: %s: %s", e.TaskID, e.Cause.Type, e.Cause.Reason)
+}
+
+// WaitForTask polls the task with the given ID every pollInterval until it completes,
+// returning its final [GetTaskResponse], which includes the operation's response.
+// A pollInterval of zero or less uses the DefaultTaskPollInterval.
+// An error is returned if
+//
+//   - ctx is done before the task completes, the task keeps running
+//   - Getting the task fails, such as when the task doesn't exist
+//   - The task completed with an error, as a [*TaskFailedError]
+func WaitForTask(ctx context.Context, client GetTask, taskID string, pollInterval time.Duration) (GetTaskResponse, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultTaskPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := client.GetTask(ctx, NewGetTaskRequest(taskID))
+		if err != nil {
+			return resp.Response, err
+		}
+
+		if resp.Response.Error != nil {
+			return resp.Response, fmt.Errorf("get task %s failed: %s: %s", taskID, resp.Response.Error.Type, resp.Response.Error.Reason)
+		}
+
+		if resp.Response.Completed {
+			if resp.Response.TaskError != nil {
+				return resp.Response, &TaskFailedError{TaskID: taskID, Cause: *resp.Response.TaskError}
+			}
+
+			return resp.Response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp.Response, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package opensearchtools
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pollingTask completes after a number of polls, failing with failure if set.
+type pollingTask struct {
+	pollsToComplete int
+	failure         *Error
+	notFound        bool
+	polls           int
+}
+
+func (p *pollingTask) GetTask(_ context.Context, req *GetTaskRequest) (OpenSearchResponse[GetTaskResponse], error) {
+	p.polls++
+
+	var resp GetTaskResponse
+	switch {
+	case p.notFound:
+		resp.Error = &Error{Type: "resource_not_found_exception", Reason: "task not found"}
+		return NewOpenSearchResponse(NewValidationResults(), http.StatusNotFound, nil, resp), nil
+	case p.polls >= p.pollsToComplete:
+		resp.Completed = true
+		resp.TaskError = p.failure
+	}
+
+	resp.Task = TaskInfo{Node: "node_1", ID: 42, Action: "indices:data/write/reindex"}
+	return NewOpenSearchResponse(NewValidationResults(), http.StatusOK, nil, resp), nil
+}
+
+func TestWaitForTask(t *testing.T) {
+	client := &pollingTask{pollsToComplete: 3}
+
+	resp, err := WaitForTask(context.Background(), client, "node_1:42", time.Millisecond)
+	require.Nil(t, err)
+	require.True(t, resp.Completed)
+	require.Equal(t, "node_1:42", resp.Task.TaskID())
+	require.Equal(t, 3, client.polls)
+}
+
+func TestWaitForTask_Failed(t *testing.T) {
+	client := &pollingTask{
+		pollsToComplete: 1,
+		failure:         &Error{Type: "search_phase_execution_exception", Reason: "all shards failed"},
+	}
+
+	resp, err := WaitForTask(context.Background(), client, "node_1:42", time.Millisecond)
+	require.True(t, resp.Completed)
+
+	var taskErr *TaskFailedError
+	require.True(t, errors.As(err, &taskErr))
+	require.Equal(t, "node_1:42", taskErr.TaskID)
+	require.Equal(t, "search_phase_execution_exception", taskErr.Cause.Type)
+}
+
+func TestWaitForTask_NotFound(t *testing.T) {
+	client := &pollingTask{notFound: true}
+
+	_, err := WaitForTask(context.Background(), client, "node_1:42", time.Millisecond)
+	require.NotNil(t, err)
+	require.Equal(t, 1, client.polls)
+}
+
+func TestWaitForTask_Canceled(t *testing.T) {
+	client := &pollingTask{pollsToComplete: 1000}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	resp, err := WaitForTask(ctx, client, "node_1:42", time.Millisecond)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.False(t, resp.Completed)
+}
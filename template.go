@@ -0,0 +1,453 @@
+package opensearchtools
+
+import (
+	"context"
+)
+
+// PutComponentTemplate defines a method which knows how to make an OpenSearch [Put Component Template] request.
+// It should be implemented by a version-specific executor.
+//
+// [Put Component Template]: https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type PutComponentTemplate interface {
+	PutComponentTemplate(ctx context.Context, req *PutComponentTemplateRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// GetComponentTemplates defines a method which knows how to make an OpenSearch [Get Component Templates] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get Component Templates]: https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type GetComponentTemplates interface {
+	GetComponentTemplates(ctx context.Context, req *GetComponentTemplatesRequest) (OpenSearchResponse[GetComponentTemplatesResponse], error)
+}
+
+// DeleteComponentTemplate defines a method which knows how to make an OpenSearch [Delete Component Template] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete Component Template]: https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type DeleteComponentTemplate interface {
+	DeleteComponentTemplate(ctx context.Context, req *DeleteComponentTemplateRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// PutIndexTemplate defines a method which knows how to make an OpenSearch [Put Index Template] request.
+// It should be implemented by a version-specific executor.
+//
+// [Put Index Template]: https://opensearch.org/docs/latest/im-plugin/index-templates/
+type PutIndexTemplate interface {
+	PutIndexTemplate(ctx context.Context, req *PutIndexTemplateRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// GetIndexTemplates defines a method which knows how to make an OpenSearch [Get Index Templates] request.
+// It should be implemented by a version-specific executor.
+//
+// [Get Index Templates]: https://opensearch.org/docs/latest/im-plugin/index-templates/#retrieve-a-template
+type GetIndexTemplates interface {
+	GetIndexTemplates(ctx context.Context, req *GetIndexTemplatesRequest) (OpenSearchResponse[GetIndexTemplatesResponse], error)
+}
+
+// DeleteIndexTemplate defines a method which knows how to make an OpenSearch [Delete Index Template] request.
+// It should be implemented by a version-specific executor.
+//
+// [Delete Index Template]: https://opensearch.org/docs/latest/im-plugin/index-templates/#delete-a-template
+type DeleteIndexTemplate interface {
+	DeleteIndexTemplate(ctx context.Context, req *DeleteIndexTemplateRequest) (OpenSearchResponse[AcknowledgedResponse], error)
+}
+
+// SimulateIndexTemplate defines a method which knows how to make an OpenSearch [Simulate Index Template] request.
+// It should be implemented by a version-specific executor.
+//
+// [Simulate Index Template]: https://opensearch.org/docs/latest/im-plugin/index-templates/
+type SimulateIndexTemplate interface {
+	SimulateIndexTemplate(ctx context.Context, req *SimulateIndexTemplateRequest) (OpenSearchResponse[SimulateIndexTemplateResponse], error)
+}
+
+// Template is the section of a [ComponentTemplate] or an [IndexTemplate] applied to the indices created from it.
+type Template struct {
+	// Settings of the indices
+	Settings *IndexSettings
+
+	// Mapping of the indices
+	Mapping *Mapping
+
+	// Aliases of the indices
+	Aliases []*Alias
+}
+
+// NewTemplate instantiates an empty Template.
+func NewTemplate() *Template {
+	return &Template{}
+}
+
+// WithSettings sets the settings of the indices
+func (t *Template) WithSettings(settings *IndexSettings) *Template {
+	t.Settings = settings
+	return t
+}
+
+// WithMapping sets the mapping of the indices
+func (t *Template) WithMapping(mapping *Mapping) *Template {
+	t.Mapping = mapping
+	return t
+}
+
+// AddAliases adds aliases to the indices
+func (t *Template) AddAliases(aliases ...*Alias) *Template {
+	t.Aliases = append(t.Aliases, aliases...)
+	return t
+}
+
+// ComponentTemplate is a domain model union type for a component template for all supported OpenSearch versions.
+// Component templates are building blocks composed into [IndexTemplate]s.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/#composable-index-templates
+type ComponentTemplate struct {
+	// Name of the component template
+	Name string
+
+	// Template applied to the indices
+	Template *Template
+
+	// Version of the component template, for external management. Omitted if zero.
+	Version int
+
+	// Meta holds arbitrary metadata about the component template
+	Meta map[string]any
+}
+
+// NewComponentTemplate instantiates a ComponentTemplate with the given name and template.
+func NewComponentTemplate(name string, template *Template) *ComponentTemplate {
+	return &ComponentTemplate{
+		Name:     name,
+		Template: template,
+	}
+}
+
+// WithVersion sets the version of the component template
+func (t *ComponentTemplate) WithVersion(version int) *ComponentTemplate {
+	t.Version = version
+	return t
+}
+
+// WithMeta sets the metadata of the component template
+func (t *ComponentTemplate) WithMeta(meta map[string]any) *ComponentTemplate {
+	t.Meta = meta
+	return t
+}
+
+// IndexTemplate is a domain model union type for a composable index template for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// For example:
+//
+//	indexTemplate := NewIndexTemplate("logs", "logs-*").
+//		AddComposedOf("logs_settings", "logs_mappings").
+//		WithPriority(100).
+//		WithTemplate(NewTemplate().AddAliases(NewAlias("logs")))
+//
+// For more details see https://opensearch.org/docs/latest/im-plugin/index-templates/
+type IndexTemplate struct {
+	// Name of the index template
+	Name string
+
+	// IndexPatterns of the names of the indices the template applies to
+	IndexPatterns []string
+
+	// Template applied to the indices, overriding the component templates
+	Template *Template
+
+	// ComposedOf are the names of the component templates merged in order
+	ComposedOf []string
+
+	// Priority of the template over the other templates matching the index. Omitted if zero.
+	Priority int
+
+	// Version of the index template, for external management. Omitted if zero.
+	Version int
+
+	// DataStream - when true, the matching names create data streams instead of indices
+	DataStream bool
+
+	// Meta holds arbitrary metadata about the index template
+	Meta map[string]any
+}
+
+// NewIndexTemplate instantiates an IndexTemplate with the given name, applying to the index patterns.
+func NewIndexTemplate(name string, indexPatterns ...string) *IndexTemplate {
+	return &IndexTemplate{
+		Name:          name,
+		IndexPatterns: indexPatterns,
+	}
+}
+
+// WithTemplate sets the template applied to the indices
+func (t *IndexTemplate) WithTemplate(template *Template) *IndexTemplate {
+	t.Template = template
+	return t
+}
+
+// AddComposedOf adds component templates to the index template
+func (t *IndexTemplate) AddComposedOf(componentTemplates ...string) *IndexTemplate {
+	t.ComposedOf = append(t.ComposedOf, componentTemplates...)
+	return t
+}
+
+// WithPriority sets the priority of the index template
+func (t *IndexTemplate) WithPriority(priority int) *IndexTemplate {
+	t.Priority = priority
+	return t
+}
+
+// WithVersion sets the version of the index template
+func (t *IndexTemplate) WithVersion(version int) *IndexTemplate {
+	t.Version = version
+	return t
+}
+
+// WithDataStream sets whether the matching names create data streams
+func (t *IndexTemplate) WithDataStream(dataStream bool) *IndexTemplate {
+	t.DataStream = dataStream
+	return t
+}
+
+// WithMeta sets the metadata of the index template
+func (t *IndexTemplate) WithMeta(meta map[string]any) *IndexTemplate {
+	t.Meta = meta
+	return t
+}
+
+// PutComponentTemplateRequest is a domain model union type for all the fields of a Put Component Template request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This PutComponentTemplateRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	putReq := NewPutComponentTemplateRequest(NewComponentTemplate("example_settings", NewTemplate().WithSettings(settings)))
+//	putResp, err := osv2Executor.PutComponentTemplate(ctx, putReq)
+type PutComponentTemplateRequest struct {
+	// Template to create or replace
+	Template *ComponentTemplate
+
+	// Create - when true, the request fails if the template already exists
+	Create bool
+}
+
+// NewPutComponentTemplateRequest instantiates a PutComponentTemplateRequest for the given template.
+func NewPutComponentTemplateRequest(template *ComponentTemplate) *PutComponentTemplateRequest {
+	return &PutComponentTemplateRequest{
+		Template: template,
+	}
+}
+
+// WithCreate sets whether the request fails if the template already exists
+func (r *PutComponentTemplateRequest) WithCreate(create bool) *PutComponentTemplateRequest {
+	r.Create = create
+	return r
+}
+
+// GetComponentTemplatesRequest is a domain model union type for all the fields of a Get Component Templates request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetComponentTemplatesRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetComponentTemplatesRequest("example_*")
+//	getResp, err := osv2Executor.GetComponentTemplates(ctx, getReq)
+type GetComponentTemplatesRequest struct {
+	// Names of the templates to get, wildcards are supported. All templates if empty.
+	Names []string
+}
+
+// NewGetComponentTemplatesRequest instantiates a GetComponentTemplatesRequest for the given template names.
+func NewGetComponentTemplatesRequest(names ...string) *GetComponentTemplatesRequest {
+	return &GetComponentTemplatesRequest{
+		Names: names,
+	}
+}
+
+// GetComponentTemplatesResponse is a domain model union response type for GetComponentTemplatesRequest
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetComponentTemplatesResponse struct {
+	// Templates found
+	Templates []*ComponentTemplate
+
+	// Error of the request, such as when a template doesn't exist
+	Error *Error
+}
+
+// DeleteComponentTemplateRequest is a domain model union type for all the fields of a Delete Component Template
+// request for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DeleteComponentTemplateRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteReq := NewDeleteComponentTemplateRequest("example_settings")
+//	deleteResp, err := osv2Executor.DeleteComponentTemplate(ctx, deleteReq)
+type DeleteComponentTemplateRequest struct {
+	// Name of the template to delete
+	Name string
+}
+
+// NewDeleteComponentTemplateRequest instantiates a DeleteComponentTemplateRequest for the given template name.
+func NewDeleteComponentTemplateRequest(name string) *DeleteComponentTemplateRequest {
+	return &DeleteComponentTemplateRequest{
+		Name: name,
+	}
+}
+
+// PutIndexTemplateRequest is a domain model union type for all the fields of a Put Index Template request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This PutIndexTemplateRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	putReq := NewPutIndexTemplateRequest(NewIndexTemplate("logs", "logs-*").AddComposedOf("logs_settings"))
+//	putResp, err := osv2Executor.PutIndexTemplate(ctx, putReq)
+type PutIndexTemplateRequest struct {
+	// Template to create or replace
+	Template *IndexTemplate
+
+	// Create - when true, the request fails if the template already exists
+	Create bool
+}
+
+// NewPutIndexTemplateRequest instantiates a PutIndexTemplateRequest for the given template.
+func NewPutIndexTemplateRequest(template *IndexTemplate) *PutIndexTemplateRequest {
+	return &PutIndexTemplateRequest{
+		Template: template,
+	}
+}
+
+// WithCreate sets whether the request fails if the template already exists
+func (r *PutIndexTemplateRequest) WithCreate(create bool) *PutIndexTemplateRequest {
+	r.Create = create
+	return r
+}
+
+// GetIndexTemplatesRequest is a domain model union type for all the fields of a Get Index Templates request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This GetIndexTemplatesRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	getReq := NewGetIndexTemplatesRequest("logs")
+//	getResp, err := osv2Executor.GetIndexTemplates(ctx, getReq)
+type GetIndexTemplatesRequest struct {
+	// Names of the templates to get, wildcards are supported. All templates if empty.
+	Names []string
+}
+
+// NewGetIndexTemplatesRequest instantiates a GetIndexTemplatesRequest for the given template names.
+func NewGetIndexTemplatesRequest(names ...string) *GetIndexTemplatesRequest {
+	return &GetIndexTemplatesRequest{
+		Names: names,
+	}
+}
+
+// GetIndexTemplatesResponse is a domain model union response type for GetIndexTemplatesRequest
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type GetIndexTemplatesResponse struct {
+	// Templates found
+	Templates []*IndexTemplate
+
+	// Error of the request, such as when a template doesn't exist
+	Error *Error
+}
+
+// DeleteIndexTemplateRequest is a domain model union type for all the fields of a Delete Index Template request
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This DeleteIndexTemplateRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	deleteReq := NewDeleteIndexTemplateRequest("logs")
+//	deleteResp, err := osv2Executor.DeleteIndexTemplate(ctx, deleteReq)
+type DeleteIndexTemplateRequest struct {
+	// Name of the template to delete
+	Name string
+}
+
+// NewDeleteIndexTemplateRequest instantiates a DeleteIndexTemplateRequest for the given template name.
+func NewDeleteIndexTemplateRequest(name string) *DeleteIndexTemplateRequest {
+	return &DeleteIndexTemplateRequest{
+		Name: name,
+	}
+}
+
+// SimulateIndexTemplateRequest is a domain model union type for all the fields of a Simulate Index Template request
+// for all supported OpenSearch versions. Nothing is created.
+// Either the templates matching the name of an index are resolved, or an index template which doesn't exist yet,
+// merged with its component templates.
+// Currently supported versions are:
+//   - OpenSearch 2
+//
+// This SimulateIndexTemplateRequest is intended to be used along with a version-specific executor such as
+// [opensearchtools/osv2.Executor]. For example:
+//
+//	simulateReq := NewSimulateIndexTemplateRequest().WithIndex("logs-2023.01.01")
+//	simulateResp, err := osv2Executor.SimulateIndexTemplate(ctx, simulateReq)
+type SimulateIndexTemplateRequest struct {
+	// Index whose settings, mappings and aliases to resolve from the existing templates
+	Index string
+
+	// Template to simulate, or to add to the existing templates when Index is set
+	Template *IndexTemplate
+}
+
+// NewSimulateIndexTemplateRequest instantiates an empty SimulateIndexTemplateRequest.
+func NewSimulateIndexTemplateRequest() *SimulateIndexTemplateRequest {
+	return &SimulateIndexTemplateRequest{}
+}
+
+// WithIndex sets the name of the index to resolve the templates of
+func (r *SimulateIndexTemplateRequest) WithIndex(index string) *SimulateIndexTemplateRequest {
+	r.Index = index
+	return r
+}
+
+// WithTemplate sets the index template to simulate
+func (r *SimulateIndexTemplateRequest) WithTemplate(template *IndexTemplate) *SimulateIndexTemplateRequest {
+	r.Template = template
+	return r
+}
+
+// SimulateIndexTemplateResponse is a domain model union response type for SimulateIndexTemplateRequest
+// for all supported OpenSearch versions.
+// Currently supported versions are:
+//   - OpenSearch 2
+type SimulateIndexTemplateResponse struct {
+	// Template resolved from the templates
+	Template *Template
+
+	// Overlapping are the templates which also match but have a lower priority
+	Overlapping []OverlappingTemplate
+
+	// Error of the request
+	Error *Error
+}
+
+// OverlappingTemplate is a template superseded by a template of higher priority in a [SimulateIndexTemplateResponse].
+type OverlappingTemplate struct {
+	// Name of the template
+	Name string
+
+	// IndexPatterns of the template
+	IndexPatterns []string
+}